@@ -12,5 +12,5 @@
 // for the channels used to pass data between the parent and child goroutines.
 //
 // This library uses Golang generics introduced in Go v1.18.
-// go1.18beta1 or higher must be used with this library.
+// go1.21 or higher must be used with this library.
 package rendezvous
@@ -1,5 +1,5 @@
 module github.com/pvillela/go-rendezvous
 
-go 1.18
+go 1.21
 
-require golang.org/x/sync v0.0.0-20210220032951-036812b2e83c
+require golang.org/x/sync v0.10.0
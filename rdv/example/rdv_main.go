@@ -10,6 +10,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"runtime"
 	"time"
 
 	"github.com/pvillela/go-rendezvous/rdv"
@@ -164,6 +165,14 @@ func main() {
 		fmt.Println(rv.ReceiveWatch(ctx))
 	}()
 
+	func() {
+		fmt.Println("\n*** RdvGo 2 Receive twice")
+		ctx := ctxTO(48 * 1)
+		rv := rdv.Go(rdv.CtxApply(ctx, f2))
+		fmt.Println(rv.Receive())
+		fmt.Println(rv.Receive())
+	}()
+
 	func() {
 		fmt.Println("\n*** RdvEgGo 12")
 		ctx := ctxTO(48 * 1)
@@ -283,4 +292,16 @@ func main() {
 		fmt.Println(rvA.ReceiveWatch(ctx))
 		fmt.Println(rvB.ReceiveWatch(ctx))
 	}()
+
+	func() {
+		fmt.Println("\n*** CtxApplyWatch 6 goroutine count")
+		before := runtime.NumGoroutine()
+		for i := 0; i < 10; i++ {
+			ctx := ctxTO(10 * 1)
+			rv := rdv.Go(rdv.CtxApplyWatch(ctx, f6))
+			fmt.Println(rv.ReceiveWatch(ctx))
+		}
+		time.Sleep(100 * 1 * time.Millisecond)
+		fmt.Println("goroutines before:", before, "after:", runtime.NumGoroutine())
+	}()
 }
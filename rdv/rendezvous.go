@@ -13,6 +13,14 @@ package rdv
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"reflect"
+	"runtime/pprof"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/pvillela/go-rendezvous/util"
 	"golang.org/x/sync/errgroup"
@@ -21,61 +29,468 @@ import (
 /////////////////////
 // Rdv
 
-// rdvData is the data structure used by Rdv channels.
+// Future is satisfied by types that encapsulate the eventual results of an asynchronous
+// computation, like Rdv.
+type Future[T any] interface {
+	Receive() (T, error)
+	ReceiveWatch(ctx context.Context) (T, error)
+}
+
+// Rdv satisfies Future.
+var _ Future[int] = Rdv[int]{}
+
+// rdvData holds the results of an asynchronous computation.
+// panicked reports whether err resulted from a panic recovered when the computation was
+// completed, as opposed to an error returned by the computation (see complete).
+// stale reports whether the computation completed after the context of the Rdv, if any, was
+// already cancelled or timed-out (see GoCtx and ReceiveWatchStale).
 type rdvData[T any] struct {
 	value    T
 	err      error
-	chanOpen bool
+	panicked bool
+	stale    bool
+}
+
+// rdvState holds the state of an Rdv. It is shared by all copies of the Rdv.
+// The done channel is closed once the results have been stored in data.
+// The cancel function, if not nil, cancels the context of the asynchronous computation.
+// The ctx context, if not nil, is watched by Receive (see GoCtx).
+// If strict is true, errors resulting from panics recovered by complete are re-panicked by the
+// receive methods (see GoStrict).
+type rdvState[T any] struct {
+	once   sync.Once
+	data   rdvData[T]
+	done   chan struct{}
+	cancel context.CancelFunc
+	ctx    context.Context
+	strict bool
 }
 
-// Rdv encapsulates a channel used for a function launched as a goroutine to rendezvous
+// Rdv encapsulates the state used for a function launched as a goroutine to rendezvous
 // with the user of the function's results.
+// The results are memoized, so an Rdv can be safely shared among multiple consumers, each of
+// which receives the same results.
+// The zero value of Rdv is not associated with any computation, so its receive methods
+// deliver ErrNotLaunched instead of blocking forever.
 type Rdv[T any] struct {
-	ch chan rdvData[T]
+	state *rdvState[T]
+}
+
+// ErrNotLaunched is the error delivered by the zero value of Rdv, which is not associated with
+// any asynchronous computation.
+var ErrNotLaunched = errors.New("rendezvous not launched")
+
+// launched returns the receiver if it was properly constructed.
+// Otherwise, the receiver is the zero value of Rdv, and launched returns an Rdv whose results
+// are immediately available, with ErrNotLaunched as the error.
+func (rv Rdv[T]) launched() Rdv[T] {
+	if rv.state == nil {
+		var zero T
+		return Completed(zero, ErrNotLaunched)
+	}
+	return rv
+}
+
+// newRdv constructs an Rdv whose results have not yet been delivered.
+func newRdv[T any]() Rdv[T] {
+	return Rdv[T]{&rdvState[T]{done: make(chan struct{})}}
+}
+
+// Completed constructs an Rdv whose results, value and err, are immediately available,
+// without launching a goroutine. This is useful to return known or cached values through an
+// API that returns an Rdv or a Future, and to inject known results in tests.
+func Completed[T any](value T, err error) Rdv[T] {
+	rv := newRdv[T]()
+	rv.memoize(rdvData[T]{value: value, err: err})
+	return rv
+}
+
+// memoize stores data as the result of the receiver, unless a result has already been stored.
+func (rv Rdv[T]) memoize(data rdvData[T]) {
+	rv.state.once.Do(func() {
+		rv.state.data = data
+		close(rv.state.done)
+	})
+}
+
+// complete executes f, converting a panic into an error, and delivers its results to
+// the receiver, recording whether f panicked and whether the receiver's context, if any, was
+// done by the time f returned. It returns the error delivered, which is ErrNilFunc if f is nil.
+func (rv Rdv[T]) complete(f func() (T, error)) error {
+	if f == nil {
+		var zero T
+		rv.memoize(rdvData[T]{value: zero, err: ErrNilFunc})
+		return ErrNilFunc
+	}
+	panicked := true
+	res, err := util.SafeFunc0E(func() (T, error) {
+		res, err := f()
+		panicked = false
+		return res, err
+	})()
+	ctx := rv.state.ctx
+	stale := ctx != nil && ctx.Err() != nil
+	rv.memoize(rdvData[T]{value: res, err: err, panicked: panicked, stale: stale})
+	return err
+}
+
+// results returns the results stored in the receiver, which must be available, re-panicking
+// with the original panic value if the receiver is strict and its computation panicked.
+// An error resulting from a panic that was returned by the computation, e.g., received from
+// another Rdv, is not re-panicked.
+func (rv Rdv[T]) results() (T, error) {
+	data := rv.state.data
+	if rv.state.strict && data.panicked {
+		var pe util.PanicError
+		if errors.As(data.err, &pe) {
+			panic(pe.Value)
+		}
+	}
+	return data.value, data.err
+}
+
+// receive is like Receive, except that it never re-panics, even for a strict receiver, in
+// which case a panic in the computation is delivered as a util.PanicError.
+// It is used by the goroutines launched by this package, where a re-panic could not be
+// recovered by the caller and would crash the program.
+func (rv Rdv[T]) receive() (T, error) {
+	rv = rv.launched()
+	if ctx := rv.state.ctx; ctx != nil {
+		if !rv.await(ctx.Done()) {
+			var zero T
+			return zero, util.ContextErr(ctx)
+		}
+	} else {
+		rv.await(nil)
+	}
+	return rv.state.data.value, rv.state.data.err
+}
+
+// await waits until the result of the receiver is available or the cancel channel is closed,
+// whichever comes first, and reports whether the result is available.
+// A nil cancel channel is never closed.
+func (rv Rdv[T]) await(cancel <-chan struct{}) bool {
+	select {
+	case <-rv.state.done:
+		return true
+	case <-cancel:
+		return false
+	}
 }
 
 // Receive waits on the receiver and returns the results of the asynchronous computation for
 // which the receiver was created (see Go and GoEg).
-// For this method and ReceiveWatch, altogether at most one invocation is allowed for a given
-// receiver.
+// This method and ReceiveWatch may be called any number of times, from any goroutine, and
+// always return the same results once the computation has completed.
+// If the receiver was created with GoCtx, this method watches the context passed to GoCtx,
+// like ReceiveWatch.
 func (rv Rdv[T]) Receive() (T, error) {
-	data := <-rv.ch
-	if !data.chanOpen {
-		panic("attempt to get data from closed rendezvous channel")
+	rv = rv.launched()
+	if ctx := rv.state.ctx; ctx != nil {
+		return rv.ReceiveWatch(ctx)
 	}
-	return data.value, data.err
+	rv.await(nil)
+	return rv.results()
 }
 
 // ReceiveWatch waits on the receiver and watches the context ctx for cancellation or timeout.
 // If ctx is not cancelled or times-out, this function returns the results of the asynchronous
 // computation for which the receiver was created (see Go and GoEg).
-// Otherwise, this function returns early with a TimeoutError or CancellationError.
-// For this method and Receive, altogether at most one invocation is allowed for a given
-// receiver.
+// Otherwise, this function returns early with a TimeoutError or CancellationError which, if
+// ctx was cancelled with a cause (see context.WithCancelCause), also wraps the cause (see
+// util.ContextErr).
+// An early return does not affect the results returned by subsequent invocations of this
+// method or Receive.
 func (rv Rdv[T]) ReceiveWatch(ctx context.Context) (T, error) {
-	data := rdvData[T]{}
+	rv = rv.launched()
+	if !rv.await(ctx.Done()) {
+		var zero T
+		return zero, util.ContextErr(ctx)
+	}
+	return rv.results()
+}
+
+// ReceiveWatchStale is like ReceiveWatch, except that it also reports whether the results
+// returned are stale, i.e., the asynchronous computation completed after a context was
+// already cancelled or timed-out. This is determined when the computation completes, not when
+// the results are received: results that were available before ctx was done are not stale,
+// even if they are received afterwards.
+// For a receiver created with GoCtx, the results are stale if the computation completed after
+// the context passed to GoCtx was done. For any receiver, the results are also stale if ctx
+// was done before the results became available and they became available by the time this
+// method returns, in which case they are returned instead of a TimeoutError or
+// CancellationError. This helps callers decide whether to trust a late-arriving result.
+func (rv Rdv[T]) ReceiveWatchStale(ctx context.Context) (T, error, bool) {
+	rv = rv.launched()
 	select {
-	case data = <-rv.ch:
-		if !data.chanOpen {
-			panic("attempt to get data from closed rendezvous channel")
+	case <-rv.state.done:
+		value, err := rv.results()
+		return value, err, rv.state.data.stale
+	default:
+	}
+	if !rv.await(ctx.Done()) {
+		if value, err, ok := rv.TryReceive(); ok {
+			return value, err, true
 		}
-	case <-ctx.Done():
-		data.err = ctx.Err()
+		var zero T
+		return zero, util.ContextErr(ctx), false
 	}
-	return data.value, data.err
+	value, err := rv.results()
+	return value, err, rv.state.data.stale
+}
+
+// ReceiveWatchReport is like ReceiveWatch, except that it also returns how long this method
+// waited for the results or for ctx to be cancelled or time-out, measured with a monotonic
+// clock from the moment this method is entered.
+func (rv Rdv[T]) ReceiveWatchReport(ctx context.Context) (T, error, time.Duration) {
+	start := time.Now()
+	value, err := rv.ReceiveWatch(ctx)
+	return value, err, time.Since(start)
+}
+
+// ReceiveOr is like ReceiveWatch, except that it returns def instead of an error.
+// def is returned if ctx is cancelled or times-out before the results of the asynchronous
+// computation are available, or if the computation returned an error.
+// This method swallows errors by design and is intended for best-effort uses, like caches,
+// where a failure should transparently fall back to a default.
+func (rv Rdv[T]) ReceiveOr(ctx context.Context, def T) T {
+	value, err := rv.ReceiveWatch(ctx)
+	if err != nil {
+		return def
+	}
+	return value
+}
+
+// ReceiveTimeout waits on the receiver for at most the duration d.
+// It behaves like ReceiveWatch with a context that times-out after d, returning early with a
+// TimeoutError if d elapses before the results of the asynchronous computation are available.
+func (rv Rdv[T]) ReceiveTimeout(d time.Duration) (T, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), d)
+	defer cancel()
+	return rv.ReceiveWatch(ctx)
+}
+
+// ReceiveSelect waits on the receiver and on the channel cancel, for codebases that signal
+// cancellation with a channel rather than a context.Context.
+// If the results of the asynchronous computation are available before cancel is closed, this
+// method returns them and false. Otherwise, it returns the zero value of T, a nil error, and
+// true to indicate that it was interrupted. A nil cancel channel is never closed.
+// An interruption does not affect the results returned by subsequent invocations of this
+// method or the other receive methods.
+func (rv Rdv[T]) ReceiveSelect(cancel <-chan struct{}) (T, error, bool) {
+	rv = rv.launched()
+	if !rv.await(cancel) {
+		var zero T
+		return zero, nil, true
+	}
+	value, err := rv.results()
+	return value, err, false
+}
+
+// TryReceive returns immediately, without blocking. If the results of the asynchronous
+// computation for which the receiver was created are available, this method returns them
+// and true. Otherwise, it returns the zero value of T, a nil error, and false.
+func (rv Rdv[T]) TryReceive() (T, error, bool) {
+	rv = rv.launched()
+	select {
+	case <-rv.state.done:
+		value, err := rv.results()
+		return value, err, true
+	default:
+		var zero T
+		return zero, nil, false
+	}
+}
+
+// Done returns a channel that is closed when the results of the asynchronous computation for
+// which the receiver was created are available.
+// This method does not affect the results returned by the receive methods.
+func (rv Rdv[T]) Done() <-chan struct{} {
+	rv = rv.launched()
+	return rv.state.done
+}
+
+// Context returns the context with which the asynchronous computation for which the receiver
+// was created is executed, if the receiver was created with GoCtx, e.g., to inspect its
+// deadline. Otherwise, it returns context.Background(), which is never cancelled and has no
+// deadline.
+func (rv Rdv[T]) Context() context.Context {
+	rv = rv.launched()
+	if ctx := rv.state.ctx; ctx != nil {
+		return ctx
+	}
+	return context.Background()
+}
+
+// String implements fmt.Stringer, reporting the state of the receiver for debugging: "Rdv[pending]"
+// if the results of the asynchronous computation are not yet available, and a summary of the
+// results otherwise.
+// This method does not block and does not affect the results returned by the receive methods.
+func (rv Rdv[T]) String() string {
+	rv = rv.launched()
+	select {
+	case <-rv.state.done:
+	default:
+		return "Rdv[pending]"
+	}
+	if err := rv.state.data.err; err != nil {
+		return fmt.Sprintf("Rdv[error: %v]", err)
+	}
+	return fmt.Sprintf("Rdv[ok: %v]", rv.state.data.value)
+}
+
+// Cancel abandons the asynchronous computation for which the receiver was created.
+// If the results of the computation are not yet available, subsequent invocations of the
+// receive methods return a CancellationError. Otherwise, this method has no effect on the
+// results.
+// If the receiver was created with a context it controls (see GoWithTimeout, GoWithDeadline,
+// and GoCancelable), that context is cancelled, so a context-aware computation can stop early.
+// Otherwise, the computation runs to completion and its results are discarded.
+// This method may be called any number of times.
+func (rv Rdv[T]) Cancel() {
+	rv = rv.launched()
+	var zero T
+	rv.memoize(rdvData[T]{value: zero, err: context.Canceled})
+	if rv.state.cancel != nil {
+		rv.state.cancel()
+	}
+}
+
+// OnComplete registers f to be invoked exactly once, in a separate goroutine, with the results
+// of the asynchronous computation for which the receiver was created, as soon as they are
+// available.
+// Because results are memoized, this method can be combined with Receive and the other
+// receive methods, as well as called multiple times. Callbacks registered with multiple
+// invocations of this method are executed concurrently, in no particular order.
+// A panic in f is recovered and discarded. For a receiver created with GoStrict, a panic in
+// its computation is passed to f as a util.PanicError instead of being re-panicked.
+func (rv Rdv[T]) OnComplete(f func(T, error)) {
+	go func() {
+		value, err := rv.receive()
+		_ = util.SafeFunc0V(func() { f(value, err) })()
+	}()
+}
+
+// ReceiveAll receives from each of the rvs, in order, and returns a slice with the values and a
+// slice with the errors received, each in the order of the corresponding rvs.
+func ReceiveAll[T any](rvs []Rdv[T]) ([]T, []error) {
+	values := make([]T, len(rvs))
+	errs := make([]error, len(rvs))
+	for i, rv := range rvs {
+		values[i], errs[i] = rv.Receive()
+	}
+	return values, errs
+}
+
+// ReceiveAllWatch is like ReceiveAll but watches the context ctx for cancellation or timeout
+// across all receives. Once ctx is cancelled or times-out, the TimeoutError or
+// CancellationError is returned for each of the rvs whose results are not yet available.
+func ReceiveAllWatch[T any](ctx context.Context, rvs []Rdv[T]) ([]T, []error) {
+	values := make([]T, len(rvs))
+	errs := make([]error, len(rvs))
+	for i, rv := range rvs {
+		values[i], errs[i] = rv.ReceiveWatch(ctx)
+	}
+	return values, errs
 }
 
+// Must receives from rv and returns the value received, panicking with the error received if
+// it is not nil.
+// It is intended for initialization code and other cases where a failure of the asynchronous
+// computation should abort the program.
+func Must[T any](rv Future[T]) T {
+	value, err := rv.Receive()
+	if err != nil {
+		panic(err)
+	}
+	return value
+}
+
+// MustWatch is like Must but watches the context ctx for cancellation or timeout, panicking
+// with the TimeoutError or CancellationError if ctx is cancelled or times-out first.
+func MustWatch[T any](ctx context.Context, rv Future[T]) T {
+	value, err := rv.ReceiveWatch(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return value
+}
+
+// WaitAny waits until any of dones completes or the context ctx is cancelled or times-out,
+// whichever comes first. dones typically are Rdv instances, possibly of different types.
+// If one of dones completes first, this function returns its index and a nil error, so the
+// caller can then receive from that specific Rdv. Otherwise, it returns -1 and a TimeoutError
+// or CancellationError.
+// This function does not consume any result.
+func WaitAny(ctx context.Context, dones ...interface{ Done() <-chan struct{} }) (int, error) {
+	cases := make([]reflect.SelectCase, len(dones)+1)
+	for i, d := range dones {
+		cases[i] = reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(d.Done())}
+	}
+	cases[len(dones)] = reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(ctx.Done())}
+
+	chosen, _, _ := reflect.Select(cases)
+	if chosen == len(dones) {
+		return -1, util.ContextErr(ctx)
+	}
+	return chosen, nil
+}
+
+// goLauncher launches the goroutines of Go, GoCtx, GoStrict, and GoWg. It is intended for
+// testing only, e.g., to substitute a synchronous or otherwise controlled scheduler for
+// deterministic tests of the rendezvous. It must be set before any of those functions is
+// called.
+var goLauncher = func(f func()) { go f() }
+
+// ErrNilFunc is the error delivered by an Rdv launched with a nil function, instead of the
+// error that would result from the nil-pointer dereference.
+var ErrNilFunc = errors.New("nil function")
+
 // Go launches f as an asynchronous computation in a goroutine and returns an Rdv instance
 // to be used to retrieve the results of the computation.
+// The goroutine never blocks on the delivery of the results, so it terminates as soon as f
+// returns, whether or not the results are ever received.
 func Go[T any](f func() (T, error)) Rdv[T] {
-	rv := Rdv[T]{make(chan rdvData[T], 1)}
-	go func() {
-		defer close(rv.ch)
-		fs := util.SafeFunc0E(f)
-		res, err := fs()
-		data := rdvData[T]{res, err, true}
-		rv.ch <- data
-	}()
+	rv := newRdv[T]()
+	goLauncher(func() { rv.complete(f) })
+	return rv
+}
+
+// GoSimple is the same as Go. It makes explicit at the call site that f is a context-free
+// computation, e.g., CPU-bound work, whose results are meant to be retrieved with Receive
+// rather than with any of the watching receive methods.
+// For context-aware computations, see CtxApply, CtxApplyWatch, and GoWithTimeout.
+func GoSimple[T any](f func() (T, error)) Rdv[T] {
+	return Go(f)
+}
+
+// GoCtx launches f as an asynchronous computation in a goroutine, with the context ctx, and
+// returns an Rdv instance to be used to retrieve the results of the computation.
+// The Rdv retains ctx, so its Receive method watches ctx for cancellation or timeout without
+// the need to call ReceiveWatch. This is equivalent to Go(CtxApply(ctx, f)) with every
+// Receive replaced by ReceiveWatch(ctx).
+func GoCtx[T any](ctx context.Context, f func(context.Context) (T, error)) Rdv[T] {
+	rv := newRdv[T]()
+	rv.state.ctx = ctx
+	fc := CtxApply(ctx, f)
+	goLauncher(func() { rv.complete(fc) })
+	return rv
+}
+
+// GoStrict is like Go, except that a panic in f is not converted to an error for the
+// consumers of the results. Instead, the receive methods of the returned Rdv re-panic on the
+// goroutine that invokes them, for callers that prefer panics to crash the program.
+// The value re-panicked is the value recovered from the panic in f. The stack trace of the
+// goroutine that executed f at the time of the panic is not part of the re-panic; it is passed
+// to util.OnPanic, if set, when the panic in f is recovered.
+// Only a panic in f itself is re-panicked: a util.PanicError returned by f, e.g., received from
+// another Rdv, is delivered as an error.
+// The String, OnComplete, and AsChannel methods of the Rdv do not re-panic, as the latter two
+// deliver the results on goroutines that the caller could not recover a panic from.
+func GoStrict[T any](f func() (T, error)) Rdv[T] {
+	rv := newRdv[T]()
+	rv.state.strict = true
+	goLauncher(func() { rv.complete(f) })
 	return rv
 }
 
@@ -83,39 +498,778 @@ func Go[T any](f func() (T, error)) Rdv[T] {
 // errgroup.Group eg and returns an Rdv instance to be used to retrieve the results of
 // the computation.
 func GoEg[T any](eg *errgroup.Group, f func() (T, error)) Rdv[T] {
-	rv := Rdv[T]{make(chan rdvData[T], 1)}
+	rv := newRdv[T]()
 	eg.Go(func() error {
-		defer close(rv.ch)
-		fs := util.SafeFunc0E(f)
-		res, err := fs()
-		data := rdvData[T]{res, err, true}
-		rv.ch <- data
-		return err
+		return rv.complete(f)
 	})
 	return rv
 }
 
+// GoEgQuiet is like GoEg, except that the error of f is delivered only through the Rdv and
+// never returned to eg.
+// With GoEg, an error of f is returned to eg, so it is the error returned by eg.Wait, if it is
+// the first, and it cancels the derived context of eg if eg was created with
+// errgroup.WithContext, aborting the other computations associated with eg.
+// With GoEgQuiet, the computation takes part in eg.Wait, but its errors never affect the other
+// computations associated with eg nor the error returned by eg.Wait.
+func GoEgQuiet[T any](eg *errgroup.Group, f func() (T, error)) Rdv[T] {
+	rv := newRdv[T]()
+	eg.Go(func() error {
+		_ = rv.complete(f)
+		return nil
+	})
+	return rv
+}
+
+// GoWg launches f as an asynchronous computation in a goroutine associated with the
+// sync.WaitGroup wg and returns an Rdv instance to be used to retrieve the results of
+// the computation.
+// wg.Add(1) is called before the goroutine is launched and wg.Done() is called when the
+// results of the computation are available.
+func GoWg[T any](wg *sync.WaitGroup, f func() (T, error)) Rdv[T] {
+	rv := newRdv[T]()
+	wg.Add(1)
+	goLauncher(func() {
+		defer wg.Done()
+		_ = rv.complete(f)
+	})
+	return rv
+}
+
+// GoV launches the value-less function f as an asynchronous computation in a goroutine and
+// returns an Rdv instance to be used to await the completion of the computation and retrieve
+// its error result.
+func GoV(f func() error) Rdv[util.Unit] {
+	if f == nil {
+		return Completed(util.Unit{}, ErrNilFunc)
+	}
+	fs := util.SafeFunc0VE(f)
+	return Go(func() (util.Unit, error) {
+		return util.Unit{}, fs()
+	})
+}
+
+// GoEgV launches the value-less function f as an asynchronous computation in a goroutine
+// associated with the errgroup.Group eg and returns an Rdv instance to be used to await the
+// completion of the computation and retrieve its error result.
+func GoEgV(eg *errgroup.Group, f func() error) Rdv[util.Unit] {
+	if f == nil {
+		return GoEg[util.Unit](eg, nil)
+	}
+	fs := util.SafeFunc0VE(f)
+	return GoEg(eg, func() (util.Unit, error) {
+		return util.Unit{}, fs()
+	})
+}
+
+// GoTimed is like Go, except that it also returns a function that reports the elapsed
+// wall-clock time of the computation, from its launch until f returns, measured with a
+// monotonic clock. The function returned blocks until f returns. If f is nil, the Rdv
+// delivers ErrNilFunc and the function returned reports 0.
+func GoTimed[T any](f func() (T, error)) (Rdv[T], func() time.Duration) {
+	if f == nil {
+		var zero T
+		return Completed(zero, ErrNilFunc), func() time.Duration { return 0 }
+	}
+	var elapsed time.Duration
+	timed := make(chan struct{})
+	start := time.Now()
+	rv := Go(func() (T, error) {
+		defer func() {
+			elapsed = time.Since(start)
+			close(timed)
+		}()
+		return f()
+	})
+	return rv, func() time.Duration {
+		<-timed
+		return elapsed
+	}
+}
+
+// GoLabeled is like Go, except that f is executed with the pprof labels of ctx augmented
+// with labels (see pprof.Do), so that the goroutine can be identified in profiles and
+// goroutine dumps.
+func GoLabeled[T any](ctx context.Context, labels pprof.LabelSet, f func() (T, error)) Rdv[T] {
+	if f == nil {
+		var zero T
+		return Completed(zero, ErrNilFunc)
+	}
+	return Go(func() (res T, err error) {
+		pprof.Do(ctx, labels, func(context.Context) {
+			res, err = f()
+		})
+		return res, err
+	})
+}
+
 // CtxApply closes function f over the ctx argument to return a nulladic function.
+// If f is nil, the resulting function returns ErrNilFunc.
 func CtxApply[T any](
 	ctx context.Context,
 	f func(context.Context) (T, error),
 ) func() (T, error) {
+	if f == nil {
+		return nilFunc[T]
+	}
 	return func() (T, error) {
 		return f(ctx)
 	}
 }
 
+// CtxApply1 closes function f over the ctx and a arguments to return a nulladic function.
+// If f is nil, the resulting function returns ErrNilFunc.
+func CtxApply1[A, T any](
+	ctx context.Context,
+	f func(context.Context, A) (T, error),
+	a A,
+) func() (T, error) {
+	if f == nil {
+		return nilFunc[T]
+	}
+	return func() (T, error) {
+		return f(ctx, a)
+	}
+}
+
+// CtxApply2 closes function f over the ctx, a, and b arguments to return a nulladic function.
+// If f is nil, the resulting function returns ErrNilFunc.
+func CtxApply2[A, B, T any](
+	ctx context.Context,
+	f func(context.Context, A, B) (T, error),
+	a A,
+	b B,
+) func() (T, error) {
+	if f == nil {
+		return nilFunc[T]
+	}
+	return func() (T, error) {
+		return f(ctx, a, b)
+	}
+}
+
 // CtxApplyWatch closes function f over the ctx argument to return a nulladic function and watches
 // ctx for deadline expiration or cancellation.
 // If ctx is not cancelled or times-out, the resulting function returns the results of f.
 // Otherwise, the resulting function returns early with a TimeoutError or CancellationError.
+// In the latter case, the results of f are discarded when f returns and the goroutine used to
+// execute f terminates. As the early return happens only once ctx is done, and f is executed
+// with ctx, a context-aware f observes the cancellation or timeout and can stop early instead
+// of running to completion with results that are ignored.
+// If ctx is already cancelled or timed-out when the resulting function is called, f is not
+// executed at all. If f is nil, the resulting function returns ErrNilFunc.
 func CtxApplyWatch[T any](
 	ctx context.Context,
 	f func(context.Context) (T, error),
 ) func() (T, error) {
-	fc := CtxApply(ctx, f)
+	if f == nil {
+		return nilFunc[T]
+	}
+	fs := util.SafeFunc1E(f)
 	return func() (T, error) {
-		rv := Go(fc)
-		return rv.ReceiveWatch(ctx)
+		if ctx.Err() != nil {
+			var zero T
+			return zero, util.ContextErr(ctx)
+		}
+		// A bare buffered channel suffices here as the results are received only once.
+		ch := make(chan rdvData[T], 1)
+		go func() {
+			res, err := fs(ctx)
+			ch <- rdvData[T]{value: res, err: err}
+		}()
+		select {
+		case data := <-ch:
+			return data.value, data.err
+		case <-ctx.Done():
+			var zero T
+			return zero, util.ContextErr(ctx)
+		}
+	}
+}
+
+// nilFunc is the function returned by CtxApply and CtxApplyWatch in place of a nil function.
+func nilFunc[T any]() (T, error) {
+	var zero T
+	return zero, ErrNilFunc
+}
+
+// GoWithTimeout launches f as an asynchronous computation in a goroutine, with a context
+// constructed from ctx with the addition of timeout, and returns an Rdv instance to be used to
+// retrieve the results of the computation.
+// The Rdv watches the derived context, so it delivers a TimeoutError or CancellationError
+// if the derived context times-out or is cancelled before f returns.
+// The derived context is cancelled as soon as the Rdv's results are available or the Rdv's
+// Cancel method is called.
+// If the derived context is already cancelled or timed-out, no goroutine is launched and the
+// returned Rdv's results are immediately available.
+// To bound a multi-stage pipeline by a single time budget, derive one context with
+// context.WithTimeout and chain the stages with AndThenCtx.
+func GoWithTimeout[T any](
+	ctx context.Context,
+	timeout time.Duration,
+	f func(context.Context) (T, error),
+) Rdv[T] {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	return goDerived(ctx, cancel, f)
+}
+
+// GoWithDeadline is like GoWithTimeout, except that the derived context has the addition of
+// the wall-clock deadline, instead of a timeout relative to the time of the launch.
+// If the deadline has already passed, no goroutine is launched and the returned Rdv
+// immediately delivers a TimeoutError.
+func GoWithDeadline[T any](
+	ctx context.Context,
+	deadline time.Time,
+	f func(context.Context) (T, error),
+) Rdv[T] {
+	ctx, cancel := context.WithDeadline(ctx, deadline)
+	return goDerived(ctx, cancel, f)
+}
+
+// GoWithIdleTimeout is like GoWithTimeout, except that the timeout is reset each time f calls
+// the heartbeat function passed to it, so that only stalls of at least idle, rather than the
+// total execution time, cause the derived context to time-out, e.g., for streaming reads that
+// report progress as data arrives.
+// When f stalls, the derived context is cancelled with context.DeadlineExceeded as the cause,
+// so the Rdv delivers a CancellationError that wraps a TimeoutError.
+// If f is nil, the Rdv delivers ErrNilFunc.
+func GoWithIdleTimeout[T any](
+	ctx context.Context,
+	idle time.Duration,
+	f func(ctx context.Context, heartbeat func()) (T, error),
+) Rdv[T] {
+	if f == nil {
+		var zero T
+		return Completed(zero, ErrNilFunc)
+	}
+	ctx, cancelCause := context.WithCancelCause(ctx)
+	timer := time.AfterFunc(idle, func() {
+		cancelCause(context.DeadlineExceeded)
+	})
+	cancel := func() {
+		timer.Stop()
+		cancelCause(nil)
+	}
+	heartbeat := func() {
+		timer.Reset(idle)
+	}
+	return goDerived(ctx, cancel, func(ctx context.Context) (T, error) {
+		return f(ctx, heartbeat)
+	})
+}
+
+// GoCancelable launches f as an asynchronous computation in a goroutine, with a cancellable
+// context derived from ctx, and returns an Rdv instance to be used to retrieve the results of
+// the computation along with a function that cancels the computation.
+// The cancel function has the same effect as the Rdv's Cancel method. It may be called any
+// number of times, including after the computation has completed.
+// The Rdv watches the derived context, so it delivers a CancellationError as soon as the
+// cancel function is called, unless the results are already available.
+// The derived context is cancelled as soon as the Rdv's results are available.
+func GoCancelable[T any](
+	ctx context.Context,
+	f func(context.Context) (T, error),
+) (Rdv[T], context.CancelFunc) {
+	ctx, cancel := context.WithCancel(ctx)
+	rv := goDerived(ctx, cancel, f)
+	return rv, rv.Cancel
+}
+
+// GoDetached launches f as an asynchronous computation in a goroutine, with a context that
+// carries the values of ctx but is not cancelled when ctx is (see context.WithoutCancel),
+// and returns an Rdv instance to be used to retrieve the results of the computation.
+// This is intended for computations that must run to completion after the caller has
+// returned, e.g., writes launched by a request handler, while retaining values like trace IDs.
+func GoDetached[T any](
+	ctx context.Context,
+	f func(context.Context) (T, error),
+) Rdv[T] {
+	return Go(CtxApply(context.WithoutCancel(ctx), f))
+}
+
+// GoBackground launches f as an asynchronous computation in a goroutine and returns an Rdv
+// instance that watches ctx, so it delivers a TimeoutError or CancellationError if ctx is
+// cancelled or times-out before f returns.
+// In all cases, onError is executed in the goroutine if f returns an error or panics, even if
+// f completes after ctx is cancelled or times-out and nobody waits on the Rdv anymore.
+// A nil onError is a no-op, and a panic in onError is recovered and discarded, so neither
+// affects the results delivered by the Rdv.
+func GoBackground[T any](
+	ctx context.Context,
+	f func(context.Context) (T, error),
+	onError func(error),
+) Rdv[T] {
+	if f == nil {
+		var zero T
+		return Completed(zero, ErrNilFunc)
+	}
+	fs := util.SafeFunc1E(f)
+	fh := func(ctx context.Context) (T, error) {
+		res, err := fs(ctx)
+		if err != nil && onError != nil {
+			_ = util.SafeFunc0V(func() { onError(err) })()
+		}
+		return res, err
+	}
+	return Go(CtxApplyWatch(ctx, fh))
+}
+
+// goDerived launches f with the context ctx, derived by the caller, which watches ctx and
+// is cancelled by the cancel function when the results are available or the Rdv is cancelled.
+// If ctx is already cancelled or timed-out, no goroutine is launched.
+func goDerived[T any](
+	ctx context.Context,
+	cancel context.CancelFunc,
+	f func(context.Context) (T, error),
+) Rdv[T] {
+	if ctx.Err() != nil {
+		err := util.ContextErr(ctx)
+		cancel()
+		var zero T
+		return Completed(zero, err)
+	}
+	fw := CtxApplyWatch(ctx, f)
+	rv := Go(func() (T, error) {
+		defer cancel()
+		return fw()
+	})
+	rv.state.cancel = cancel
+	return rv
+}
+
+// GoLogged is like Go, except that the lifecycle of the computation is logged with logger,
+// with the ctx context and the attribute name: the launch and the completion, with the
+// duration and error, if any, are logged at the Debug level, while a completion with a panic
+// converted to an error is logged at the Error level instead.
+// The Rdv watches ctx, so it delivers a TimeoutError or CancellationError if ctx times-out or
+// is cancelled before f returns, in which case "rendezvous timed out" is logged at the Debug
+// level. The completion of an abandoned f is still logged when f returns.
+// If logger is nil, nothing is logged, but the Rdv still watches ctx.
+func GoLogged[T any](
+	ctx context.Context,
+	logger *slog.Logger,
+	name string,
+	f func() (T, error),
+) Rdv[T] {
+	if f == nil {
+		var zero T
+		return Completed(zero, ErrNilFunc)
+	}
+	if logger == nil {
+		return Go(CtxApplyWatch(ctx, func(context.Context) (T, error) { return f() }))
+	}
+	nameAttr := slog.String("name", name)
+	logger.DebugContext(ctx, "rendezvous launched", nameAttr)
+	start := time.Now()
+	fs := util.SafeFunc0E(f)
+	var returned atomic.Bool
+	logged := func(context.Context) (T, error) {
+		res, err := fs()
+		returned.Store(true)
+		attrs := []any{nameAttr, slog.Duration("duration", time.Since(start))}
+		if err != nil {
+			attrs = append(attrs, slog.Any("error", err))
+		}
+		var pe util.PanicError
+		if errors.As(err, &pe) {
+			logger.ErrorContext(ctx, "rendezvous panicked", attrs...)
+		} else {
+			logger.DebugContext(ctx, "rendezvous completed", attrs...)
+		}
+		return res, err
+	}
+	fw := CtxApplyWatch(ctx, logged)
+	return Go(func() (T, error) {
+		res, err := fw()
+		if !returned.Load() {
+			logger.DebugContext(ctx, "rendezvous timed out", nameAttr,
+				slog.Duration("duration", time.Since(start)), slog.Any("error", err))
+		}
+		return res, err
+	})
+}
+
+/////////////////////
+// Retry
+
+// GoRetry launches f as an asynchronous computation in a goroutine and returns an Rdv instance
+// to be used to retrieve the results of the computation.
+// If f returns an error, it is re-run after waiting for backoff(n), where n is the number of
+// attempts made so far, until f succeeds or a total of attempts executions have been made.
+// A nil backoff means no wait between attempts.
+// Errors resulting from panics in f are not retried (see GoRetryPanics).
+// The Rdv delivers the results of the first successful attempt or the error of the last one.
+// If ctx is cancelled or times-out while waiting between attempts, the Rdv delivers a
+// TimeoutError or CancellationError.
+func GoRetry[T any](
+	ctx context.Context,
+	attempts int,
+	backoff func(attempt int) time.Duration,
+	f func(context.Context) (T, error),
+) Rdv[T] {
+	return goRetry(ctx, attempts, backoff, false, f)
+}
+
+// GoRetryPanics is like GoRetry, except that errors resulting from panics in f are also retried.
+func GoRetryPanics[T any](
+	ctx context.Context,
+	attempts int,
+	backoff func(attempt int) time.Duration,
+	f func(context.Context) (T, error),
+) Rdv[T] {
+	return goRetry(ctx, attempts, backoff, true, f)
+}
+
+// goRetry implements GoRetry and GoRetryPanics.
+func goRetry[T any](
+	ctx context.Context,
+	attempts int,
+	backoff func(attempt int) time.Duration,
+	retryPanics bool,
+	f func(context.Context) (T, error),
+) Rdv[T] {
+	if f == nil {
+		var zero T
+		return Completed(zero, ErrNilFunc)
+	}
+	return Go(func() (T, error) {
+		for n := 1; ; n++ {
+			panicked := true
+			res, err := util.SafeFunc0E(func() (T, error) {
+				res, err := f(ctx)
+				panicked = false
+				return res, err
+			})()
+			if err == nil || n >= attempts || (panicked && !retryPanics) {
+				return res, err
+			}
+
+			var wait time.Duration
+			if backoff != nil {
+				wait = backoff(n)
+			}
+			timer := time.NewTimer(wait)
+			select {
+			case <-timer.C:
+			case <-ctx.Done():
+				timer.Stop()
+				var zero T
+				return zero, util.ContextErr(ctx)
+			}
+		}
+	})
+}
+
+/////////////////////
+// Channel adapters
+
+// ErrNoResult is the error delivered by an Rdv adapted from a channel that is closed without
+// a value.
+var ErrNoResult = errors.New("channel closed without a result")
+
+// FromChannel returns an Rdv that delivers the first value received from ch, with a nil error.
+// Exactly one value is read from ch. If ch is closed without a value, the Rdv delivers the zero
+// value of T and ErrNoResult.
+func FromChannel[T any](ch <-chan T) Rdv[T] {
+	return Go(func() (T, error) {
+		value, ok := <-ch
+		if !ok {
+			return value, ErrNoResult
+		}
+		return value, nil
+	})
+}
+
+// FromResultChannel returns an Rdv that delivers the value and error of the first tuple
+// received from ch.
+// Exactly one tuple is read from ch. If ch is closed without a tuple, the Rdv delivers the
+// zero value of T and ErrNoResult.
+func FromResultChannel[T any](ch <-chan util.Tuple2[T, error]) Rdv[T] {
+	return Go(func() (T, error) {
+		res, ok := <-ch
+		if !ok {
+			return res.X1, ErrNoResult
+		}
+		return res.X1, res.X2
+	})
+}
+
+// AsChannel returns a channel on which the results of the asynchronous computation for which
+// the receiver was created are sent once, as a tuple, after which the channel is closed.
+// The channel is buffered with capacity 1, so the forwarding goroutine terminates as soon as
+// the results are available, even if nobody reads from the channel.
+// For a receiver created with GoStrict, a panic in its computation is sent as a
+// util.PanicError instead of being re-panicked.
+func (rv Rdv[T]) AsChannel() <-chan util.Tuple2[T, error] {
+	ch := make(chan util.Tuple2[T, error], 1)
+	go func() {
+		defer close(ch)
+		ch <- util.MakeTuple2(rv.receive())
+	}()
+	return ch
+}
+
+/////////////////////
+// Combinators
+
+// The combinators accept any Future and return an Rdv, which is itself a Future, so they can
+// be composed freely.
+
+// Map returns an Rdv for the transformation by f of the value produced by the asynchronous
+// computation associated with rv.
+// If rv's computation returns an error, f is not called and the resulting Rdv delivers that error.
+// A panic in f is converted to an error.
+func Map[T, U any](rv Future[T], f func(T) U) Rdv[U] {
+	return Go(func() (U, error) {
+		value, err := rv.Receive()
+		if err != nil {
+			var zero U
+			return zero, err
+		}
+		return f(value), nil
+	})
+}
+
+// AndThen returns an Rdv for the sequential composition of the asynchronous computation
+// associated with rv and the computation launched by f with the value produced by rv.
+// If rv's computation returns an error, f is not called and the resulting Rdv delivers that error.
+// A panic in f is converted to an error.
+func AndThen[T, U any](rv Future[T], f func(T) Rdv[U]) Rdv[U] {
+	return Go(func() (U, error) {
+		value, err := rv.Receive()
+		if err != nil {
+			var zero U
+			return zero, err
+		}
+		return f(value).Receive()
+	})
+}
+
+// AndThenCtx is like AndThen, except that the context ctx is passed to f and watched while
+// waiting on both rv and the Rdv launched by f.
+// When a pipeline is built by chaining AndThenCtx invocations with the same ctx, all stages
+// share ctx's deadline as a single time budget, rather than each stage getting its own.
+// In case of a context timeout or cancellation, the resulting Rdv completes early with a
+// TimeoutError or CancellationError.
+func AndThenCtx[T, U any](
+	ctx context.Context,
+	rv Future[T],
+	f func(context.Context, T) Rdv[U],
+) Rdv[U] {
+	return Go(func() (U, error) {
+		value, err := rv.ReceiveWatch(ctx)
+		if err != nil {
+			var zero U
+			return zero, err
+		}
+		return f(ctx, value).ReceiveWatch(ctx)
+	})
+}
+
+// Then returns an Rdv for the sequential composition of the asynchronous computation
+// associated with rv and the context-aware function f, executed with ctx and the value
+// produced by rv.
+// Unlike AndThenCtx, f returns plain results rather than an Rdv, and its execution is handled
+// by Then. The context ctx is watched while waiting on both rv and f.
+// If rv's computation returns an error, f is not called and the resulting Rdv delivers that error.
+// A panic in f is converted to an error. If f is nil, the resulting Rdv delivers ErrNilFunc
+// without waiting on rv.
+// In case of a context timeout or cancellation, the resulting Rdv completes early with a
+// TimeoutError or CancellationError.
+func Then[T, U any](
+	ctx context.Context,
+	rv Future[T],
+	f func(context.Context, T) (U, error),
+) Rdv[U] {
+	if f == nil {
+		var zero U
+		return Completed(zero, ErrNilFunc)
+	}
+	return Go(func() (U, error) {
+		value, err := rv.ReceiveWatch(ctx)
+		if err != nil {
+			var zero U
+			return zero, err
+		}
+		return CtxApplyWatch(ctx, func(ctx context.Context) (U, error) {
+			return f(ctx, value)
+		})()
+	})
+}
+
+// Tee returns n independent Rdv instances, each of which delivers the results of the
+// asynchronous computation associated with rv, so that each of n consumers can own one of them.
+// A single goroutine waits on rv and delivers its results to all of them.
+// As Rdv results are memoized, an Rdv can also be shared among consumers directly. With Tee,
+// Cancel on one of the returned instances affects neither the others nor rv.
+// A negative n is treated as 0, in which case no instances are returned.
+func Tee[T any](rv Future[T], n int) []Rdv[T] {
+	rvs := make([]Rdv[T], max(n, 0))
+	for i := range rvs {
+		rvs[i] = newRdv[T]()
+	}
+	go func() {
+		// The method value rv.Receive is evaluated inside the closure so that a nil rv results
+		// in an error instead of an unrecovered panic.
+		value, err := util.SafeFunc0E(func() (T, error) { return rv.Receive() })()
+		for _, rvI := range rvs {
+			rvI.memoize(rdvData[T]{value: value, err: err})
+		}
+	}()
+	return rvs
+}
+
+// Zip returns an Rdv that delivers a tuple with the values produced by the asynchronous
+// computations associated with rv1 and rv2, once both complete.
+// If either computation returns an error, the resulting Rdv delivers the error of rv1 if
+// any, and the error of rv2 otherwise.
+func Zip[T1, T2 any](rv1 Future[T1], rv2 Future[T2]) Rdv[util.Tuple2[T1, T2]] {
+	return Go(func() (util.Tuple2[T1, T2], error) {
+		value1, err1 := rv1.Receive()
+		value2, err2 := rv2.Receive()
+		if err1 != nil {
+			return util.Tuple2[T1, T2]{}, err1
+		}
+		if err2 != nil {
+			return util.Tuple2[T1, T2]{}, err2
+		}
+		return util.MakeTuple2(value1, value2), nil
+	})
+}
+
+/////////////////////
+// Group
+
+// Group deduplicates concurrent asynchronous computations identified by the same key, in the
+// manner of golang.org/x/sync/singleflight.
+// The zero value of Group is ready to use. A Group must not be copied after first use.
+type Group[T any] struct {
+	mu  sync.Mutex
+	rvs map[string]Rdv[T]
+}
+
+// Go launches f as an asynchronous computation in a goroutine and returns an Rdv instance
+// to be used to retrieve the results of the computation, unless a computation with the same
+// key is already in flight, in which case f is not executed and the Rdv of the in-flight
+// computation is returned instead, so all callers with the same key share the same results.
+// Once the computation completes, the key is forgotten and a later invocation with the same
+// key launches a new computation.
+func (g *Group[T]) Go(key string, f func() (T, error)) Rdv[T] {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if rv, ok := g.rvs[key]; ok {
+		return rv
+	}
+	if g.rvs == nil {
+		g.rvs = make(map[string]Rdv[T])
+	}
+	rv := newRdv[T]()
+	g.rvs[key] = rv
+	go func() {
+		rv.complete(f)
+		g.mu.Lock()
+		delete(g.rvs, key)
+		g.mu.Unlock()
+	}()
+	return rv
+}
+
+/////////////////////
+// Scope
+
+// Scope supports structured concurrency: asynchronous computations are spawned into a scope
+// (see Spawn) and Wait blocks until all of them have completed, so no computation outlives
+// the scope.
+type Scope struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	eg     errgroup.Group
+}
+
+// NewScope returns a Scope whose computations are executed with a context derived from ctx,
+// so they are all cancelled when ctx is cancelled.
+func NewScope(ctx context.Context) *Scope {
+	ctx, cancel := context.WithCancel(ctx)
+	return &Scope{ctx: ctx, cancel: cancel}
+}
+
+// Spawn launches f as an asynchronous computation in a goroutine associated with the scope s,
+// with the context of s, and returns an Rdv instance to be used to retrieve the results of the
+// computation.
+// Spawn is a function rather than a method of Scope because methods cannot have type
+// parameters. It must not be called after Wait has returned.
+func Spawn[T any](s *Scope, f func(context.Context) (T, error)) Rdv[T] {
+	return GoEg(&s.eg, CtxApply(s.ctx, f))
+}
+
+// Wait blocks until all computations spawned into the receiver have completed and returns the
+// first error they returned, if any. The context of the receiver is cancelled before Wait
+// returns.
+func (s *Scope) Wait() error {
+	err := s.eg.Wait()
+	s.cancel()
+	return err
+}
+
+/////////////////////
+// Registry
+
+// Registry keeps track of the asynchronous computations launched with GoTracked, so that they
+// can be awaited collectively, e.g., for a graceful shutdown (see Drain).
+// Tracking is opt-in: computations launched with Go and its other variants are not tracked.
+// The zero value of Registry is ready to use. A Registry must not be copied after first use.
+type Registry struct {
+	mu      sync.Mutex
+	running int
+	drained chan struct{}
+}
+
+// GoTracked is like Go, except that the computation is tracked by the registry r until its
+// results are available.
+func GoTracked[T any](r *Registry, f func() (T, error)) Rdv[T] {
+	r.mu.Lock()
+	if r.running == 0 {
+		r.drained = make(chan struct{})
+	}
+	r.running++
+	r.mu.Unlock()
+
+	rv := newRdv[T]()
+	go func() {
+		defer r.release()
+		rv.complete(f)
+	}()
+	return rv
+}
+
+// release records the completion of a computation tracked by the receiver.
+func (r *Registry) release() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.running--
+	if r.running == 0 {
+		close(r.drained)
+	}
+}
+
+// Drain blocks until all the computations tracked by the receiver have completed or ctx is
+// cancelled or times-out, whichever comes first. In the latter case, it returns a
+// TimeoutError or CancellationError.
+// Computations launched while this method waits are also awaited, unless all the previously
+// tracked computations have already completed.
+func (r *Registry) Drain(ctx context.Context) error {
+	r.mu.Lock()
+	if r.running == 0 {
+		r.mu.Unlock()
+		return nil
+	}
+	drained := r.drained
+	r.mu.Unlock()
+
+	select {
+	case <-drained:
+		return nil
+	case <-ctx.Done():
+		return util.ContextErr(ctx)
 	}
 }
@@ -0,0 +1,178 @@
+/*
+ * Copyright © 2021 Paulo Villela. All rights reserved.
+ * Use of this source code is governed by the Apache 2.0 license
+ * that can be found in the LICENSE file.
+ */
+
+package rdv
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/pvillela/go-rendezvous/util"
+)
+
+// waitGoroutines waits for up to one second for the number of goroutines to drop to at most
+// n and returns the last number observed.
+func waitGoroutines(n int) int {
+	deadline := time.Now().Add(time.Second)
+	for {
+		current := runtime.NumGoroutine()
+		if current <= n || time.Now().After(deadline) {
+			return current
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestNoGoroutineLeak(t *testing.T) {
+	slow := func(ctx context.Context) (int, error) {
+		time.Sleep(20 * time.Millisecond)
+		return 1, nil
+	}
+
+	before := runtime.NumGoroutine()
+
+	// Go with a Receive.
+	if _, err := Go(CtxApply(context.Background(), slow)).Receive(); err != nil {
+		t.Fatalf("Go: unexpected error %v", err)
+	}
+
+	// CtxApplyWatch returning early on a timeout.
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	if _, err := CtxApplyWatch(ctx, slow)(); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("CtxApplyWatch: got error %v, want a TimeoutError", err)
+	}
+
+	// ReceiveWatch abandoning the Rdv.
+	ctx2, cancel2 := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel2()
+	if _, err := Go(CtxApply(ctx2, slow)).ReceiveWatch(ctx2); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("ReceiveWatch: got error %v, want a TimeoutError", err)
+	}
+
+	if after := waitGoroutines(before); after > before {
+		t.Errorf("goroutines leaked: %d before, %d after", before, after)
+	}
+}
+
+func BenchmarkGoReceive(b *testing.B) {
+	f := func() (int, error) { return 1, nil }
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := Go(f).Receive(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestGoStrict(t *testing.T) {
+	panicky := func() (int, error) { panic("boom") }
+
+	t.Run("receive re-panics with the original value", func(t *testing.T) {
+		rv := GoStrict(panicky)
+		defer func() {
+			if r := recover(); r != "boom" {
+				t.Errorf("recovered %#v, want \"boom\"", r)
+			}
+		}()
+		_, _ = rv.Receive()
+		t.Error("Receive did not panic")
+	})
+
+	t.Run("OnComplete and AsChannel do not re-panic", func(t *testing.T) {
+		rv := GoStrict(panicky)
+		errs := make(chan error, 1)
+		rv.OnComplete(func(_ int, err error) { errs <- err })
+		var pe util.PanicError
+		if err := <-errs; !errors.As(err, &pe) {
+			t.Errorf("OnComplete: got error %v, want a util.PanicError", err)
+		}
+		if res := <-rv.AsChannel(); !errors.As(res.X2, &pe) {
+			t.Errorf("AsChannel: got error %v, want a util.PanicError", res.X2)
+		}
+	})
+
+	t.Run("returned PanicError is not re-panicked", func(t *testing.T) {
+		rv := GoStrict(func() (int, error) { return Go(panicky).Receive() })
+		var pe util.PanicError
+		if _, err := rv.Receive(); !errors.As(err, &pe) {
+			t.Errorf("got error %v, want a util.PanicError", err)
+		}
+	})
+}
+
+func TestGoBackgroundNilOnError(t *testing.T) {
+	errReal := errors.New("real")
+	f := func(ctx context.Context) (int, error) { return 0, errReal }
+	if _, err := GoBackground(context.Background(), f, nil).Receive(); !errors.Is(err, errReal) {
+		t.Errorf("got error %v, want %v", err, errReal)
+	}
+}
+
+func TestGoLoggedNilLoggerWatchesCtx(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	f := func() (int, error) {
+		time.Sleep(20 * time.Millisecond)
+		return 1, nil
+	}
+	if _, err := GoLogged(ctx, nil, "slow", f).Receive(); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("got error %v, want a TimeoutError", err)
+	}
+}
+
+func TestGoWithIdleTimeoutNilFunc(t *testing.T) {
+	rv := GoWithIdleTimeout[int](context.Background(), time.Second, nil)
+	if _, err := rv.Receive(); !errors.Is(err, ErrNilFunc) {
+		t.Errorf("got error %v, want ErrNilFunc", err)
+	}
+}
+
+func TestThenNilFunc(t *testing.T) {
+	rv := Then[int, int](context.Background(), Completed(1, nil), nil)
+	if _, err := rv.Receive(); !errors.Is(err, ErrNilFunc) {
+		t.Errorf("got error %v, want ErrNilFunc", err)
+	}
+}
+
+func TestReceiveWatchStale(t *testing.T) {
+	t.Run("completed before cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		rv := Go(func() (int, error) { return 5, nil })
+		<-rv.Done()
+		cancel()
+		if value, err, stale := rv.ReceiveWatchStale(ctx); value != 5 || err != nil || stale {
+			t.Errorf("got %v, %v, %v, want 5, <nil>, false", value, err, stale)
+		}
+	})
+
+	t.Run("completed after cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		rv := GoCtx(ctx, func(ctx context.Context) (int, error) {
+			<-ctx.Done()
+			return 5, nil
+		})
+		cancel()
+		<-rv.Done()
+		if value, err, stale := rv.ReceiveWatchStale(context.Background()); value != 5 ||
+			err != nil || !stale {
+			t.Errorf("got %v, %v, %v, want 5, <nil>, true", value, err, stale)
+		}
+	})
+}
+
+func TestTeeNilFuture(t *testing.T) {
+	rvs := Tee[int](nil, 2)
+	var pe util.PanicError
+	for i, rv := range rvs {
+		if _, err := rv.Receive(); !errors.As(err, &pe) {
+			t.Errorf("instance %d: got error %v, want a util.PanicError", i, err)
+		}
+	}
+}
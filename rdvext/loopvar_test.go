@@ -0,0 +1,86 @@
+//go:build go1.21
+
+/*
+ * Copyright © 2021 Paulo Villela. All rights reserved.
+ * Use of this source code is governed by the Apache 2.0 license
+ * that can be found in the LICENSE file.
+ */
+
+// The build constraint above sets the language version of this file to go1.21, so its loops
+// have the pre-1.22 semantics of a single variable shared by all iterations, which users on
+// older toolchains get. The funcs below are built without capturing loop variables, so that
+// any mismatch between the results and the inputs comes from the closures of the library.
+
+package rdvext
+
+import (
+	"context"
+	"testing"
+)
+
+// constant returns a function that returns n, without capturing a loop variable.
+func constant(n int) func(context.Context) (int, error) {
+	return func(context.Context) (int, error) { return n, nil }
+}
+
+func TestLoopVarSemantics(t *testing.T) {
+	var funcs []func() int
+	for i := 0; i < 2; i++ {
+		funcs = append(funcs, func() int { return i })
+	}
+	if funcs[0]() != 2 {
+		t.Fatal("this file is not compiled with pre-1.22 loop variable semantics")
+	}
+}
+
+func TestLibraryClosuresSeeOwnInputs(t *testing.T) {
+	const n = 50
+	ctx := context.Background()
+	inputs := make([]int, n)
+	funcs := make([]func(context.Context) (int, error), n)
+	for i := range inputs {
+		inputs[i] = i * 10
+		funcs[i] = constant(i * 10)
+	}
+
+	check := func(name string, got []int) {
+		t.Helper()
+		for i, input := range inputs {
+			if got[i] != input {
+				t.Errorf("%s: result %d is %d, want %d", name, i, got[i], input)
+			}
+		}
+	}
+	values := func(results []ResultWithError[int]) []int {
+		vs := make([]int, len(results))
+		for i, r := range results {
+			vs[i] = r.Value
+		}
+		return vs
+	}
+
+	identity := func(ctx context.Context, input int) (int, error) { return input, nil }
+	mapped, err := GoMap(ctx, inputs, identity).Receive()
+	if err != nil {
+		t.Fatalf("GoMap: unexpected error %v", err)
+	}
+	check("GoMap", values(mapped))
+
+	sliced, err := RunSlice(ctx, funcs...)
+	if err != nil {
+		t.Fatalf("RunSlice: unexpected error %v", err)
+	}
+	check("RunSlice", values(sliced))
+
+	progress, err := RunSliceEgProgress(ctx, func(int, int) {}, funcs...)
+	if err != nil {
+		t.Fatalf("RunSliceEgProgress: unexpected error %v", err)
+	}
+	check("RunSliceEgProgress", progress)
+
+	streamed := make([]int, n)
+	for item := range GoStream(ctx, funcs...) {
+		streamed[item.X1] = item.X2.Value
+	}
+	check("GoStream", streamed)
+}
@@ -9,6 +9,12 @@ package rdvext
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/pvillela/go-rendezvous/rdv"
 	"github.com/pvillela/go-rendezvous/util"
@@ -18,46 +24,300 @@ import (
 /////////////////////
 // ResultWithError
 
-// ResultWithError encapsulates a normal result value and an error.
+// ResultWithError encapsulates a normal result value and an error, and reports whether the
+// error resulted from a panic (see util.PanicError).
+// See ToResult for a conversion to util.Result, which provides additional methods.
 type ResultWithError[T any] struct {
-	Value T
-	Error error
+	Value    T
+	Error    error
+	Panicked bool
+}
+
+// makeResultWithError constructs a ResultWithError from value and err, setting Panicked as
+// util.MakeResult does.
+func makeResultWithError[T any](value T, err error) ResultWithError[T] {
+	return FromResult(util.MakeResult(value, err))
+}
+
+// ToResult converts the receiver to a util.Result.
+func (r ResultWithError[T]) ToResult() util.Result[T] {
+	return util.Result[T]{Value: r.Value, Err: r.Error, Panicked: r.Panicked}
+}
+
+// FromResult converts a util.Result to a ResultWithError.
+func FromResult[T any](r util.Result[T]) ResultWithError[T] {
+	return ResultWithError[T]{Value: r.Value, Error: r.Err, Panicked: r.Panicked}
+}
+
+// TimedResult is a ResultWithError with the addition of the duration of the execution of
+// the function that produced it (see RunSliceTimed).
+type TimedResult[T any] struct {
+	ResultWithError[T]
+	Duration time.Duration
 }
 
 /////////////////////
 // Run multiple
 
+// nonNilFuncs returns funcs, or a copy of funcs in which each nil function is replaced by a
+// function that returns an error wrapping rdv.ErrNilFunc and identifying the index of the nil
+// function in funcs.
+func nonNilFuncs[T any](funcs []func(context.Context) (T, error)) []func(context.Context) (T, error) {
+	var checked []func(context.Context) (T, error)
+	for i, f := range funcs {
+		if f != nil {
+			continue
+		}
+		if checked == nil {
+			checked = append([]func(context.Context) (T, error){}, funcs...)
+		}
+		err := fmt.Errorf("%w at index %d", rdv.ErrNilFunc, i)
+		checked[i] = func(context.Context) (T, error) {
+			var zero T
+			return zero, err
+		}
+	}
+	if checked == nil {
+		return funcs
+	}
+	return checked
+}
+
 // RunSlice runs funcs concurrently and returns a slice containing the results of
 // the function executions once all functions complete normaly, with an error, or with a panic.
 // Panics in function executions are converted to errors.
 // In case of a context timeout or cancellation, this functionn returns early with a
 // TimeoutError or CancellationError for each of the funcs that had not aready returned.
+// The funcs that remain to be launched once the context is timed-out or cancelled are not
+// launched at all.
 // If there are any errors, the returned error is the one associated with the first function
 // in the list of aguments that has an error response (not necessarily the first function to
 // return an error).
+// A panic in this function's own aggregation of the results is also converted to an error, in
+// which case the returned slice is nil.
 func RunSlice[T any](
 	ctx context.Context,
 	funcs ...func(context.Context) (T, error),
 ) ([]ResultWithError[T], error) {
+	return RunSlicePolicy(ctx, FirstError, funcs...)
+}
+
+// RunSlicePolicy is like RunSlice, except that the returned error is chosen or combined by
+// pick, which receives the errors of all the function executions, in the order of the
+// corresponding functions in the list of arguments, with nils for the successful ones.
+// See FirstError, LastError, and AllErrors for built-in policies.
+// A panic in pick is converted to an error like a panic in this function's own aggregation
+// of the results.
+func RunSlicePolicy[T any](
+	ctx context.Context,
+	pick func(errs []error) error,
+	funcs ...func(context.Context) (T, error),
+) ([]ResultWithError[T], error) {
+	return util.SafeFunc0E(func() ([]ResultWithError[T], error) {
+		return runSlice(ctx, pick, funcs...)
+	})()
+}
+
+// runSlice implements RunSlicePolicy, without the protection against panics in the
+// aggregation of the results.
+func runSlice[T any](
+	ctx context.Context,
+	pick func(errs []error) error,
+	funcs ...func(context.Context) (T, error),
+) ([]ResultWithError[T], error) {
+	funcs = nonNilFuncs(funcs)
 	rvs := make([]rdv.Rdv[T], len(funcs))
 	for i, f := range funcs {
+		// Once ctx is done, the remaining functions would only be abandoned, so they are not
+		// launched.
+		if ctx.Err() != nil {
+			var zero T
+			rvs[i] = rdv.Completed(zero, util.ContextErr(ctx))
+			continue
+		}
 		rvs[i] = rdv.Go(rdv.CtxApply(ctx, f))
 	}
 
 	results := make([]ResultWithError[T], len(funcs))
 	for i := 0; i < len(rvs); i++ {
-		results[i].Value, results[i].Error = rvs[i].ReceiveWatch(ctx)
+		results[i] = makeResultWithError(rvs[i].ReceiveWatch(ctx))
 	}
 
-	var err error = nil
+	errs := make([]error, len(results))
+	for i, res := range results {
+		errs[i] = res.Error
+	}
+
+	return results, pick(errs)
+}
+
+// FirstError is an error policy for RunSlicePolicy that returns the first non-nil error in
+// errs, or nil if there is none. It is the policy of RunSlice.
+func FirstError(errs []error) error {
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// LastError is an error policy for RunSlicePolicy that returns the last non-nil error in
+// errs, or nil if there is none.
+func LastError(errs []error) error {
+	for i := len(errs) - 1; i >= 0; i-- {
+		if errs[i] != nil {
+			return errs[i]
+		}
+	}
+	return nil
+}
+
+// AllErrors is an error policy for RunSlicePolicy that returns a *util.MultiError aggregating
+// the non-nil errors in errs, or nil if there is none.
+func AllErrors(errs []error) error {
+	return util.MakeMultiError(errs...)
+}
+
+// RunSliceFirstFailed is like RunSlice, except that it also returns the index of the function
+// that failed first chronologically, and the returned error is the error of that function.
+// If no function failed before the context timed-out or was cancelled, the index is that of
+// the first function in the list of arguments that was abandoned due to the timeout or
+// cancellation. If there are no errors, the index is -1.
+func RunSliceFirstFailed[T any](
+	ctx context.Context,
+	funcs ...func(context.Context) (T, error),
+) ([]ResultWithError[T], int, error) {
+	funcs = nonNilFuncs(funcs)
+	var firstFailed atomic.Int32
+	firstFailed.Store(-1)
+
+	recording := make([]func(context.Context) (T, error), len(funcs))
+	for i, f := range funcs {
+		i, fs := i, util.SafeFunc1E(f)
+		recording[i] = func(ctx context.Context) (T, error) {
+			res, err := fs(ctx)
+			if err != nil && ctx.Err() == nil {
+				firstFailed.CompareAndSwap(-1, int32(i))
+			}
+			return res, err
+		}
+	}
+
+	results, err := RunSlice(ctx, recording...)
+
+	if index := int(firstFailed.Load()); index >= 0 {
+		return results, index, results[index].Error
+	}
+	if err != nil {
+		for i, res := range results {
+			if res.Error != nil {
+				return results, i, res.Error
+			}
+		}
+	}
+	return results, -1, nil
+}
+
+// RunSliceAllSettled runs funcs concurrently and, once all functions complete normally, with an
+// error, or with a panic, returns a slice containing the non-error results of the function
+// executions, in the order of the corresponding functions in the list of arguments.
+// Panics in function executions are converted to errors.
+// In case of a context timeout or cancellation, this function returns early with a
+// TimeoutError or CancellationError for each of the funcs that had not already returned.
+// The returned error is a *util.MultiError that aggregates the errors of all the functions
+// that failed, in the order of the corresponding functions in the list of arguments, and is
+// nil if none failed.
+func RunSliceAllSettled[T any](
+	ctx context.Context,
+	funcs ...func(context.Context) (T, error),
+) ([]T, error) {
+	results, _ := RunSlice(ctx, funcs...)
+
+	values := make([]T, 0, len(results))
+	var errs []error
 	for _, res := range results {
 		if res.Error != nil {
-			err = res.Error
-			break
+			errs = append(errs, res.Error)
+			continue
 		}
+		values = append(values, res.Value)
 	}
 
-	return results, err
+	return values, util.MakeMultiError(errs...)
+}
+
+// RunSliceCompact runs funcs concurrently and, once all functions complete normally, with an
+// error, or with a panic, returns a slice containing only the non-error results of the function
+// executions, in the order of the corresponding functions in the list of arguments.
+// In case of a context timeout or cancellation, this function returns early, and the funcs that
+// had not already returned are treated as failed.
+// Unlike RunSlice, errors, including those resulting from panics, timeouts, and cancellations,
+// are intentionally discarded.
+func RunSliceCompact[T any](
+	ctx context.Context,
+	funcs ...func(context.Context) (T, error),
+) []T {
+	values, _ := RunSliceAllSettled(ctx, funcs...)
+	return values
+}
+
+// RunSliceTimed is like RunSlice, except that each result also includes the duration of the
+// execution of the corresponding function, measured with a monotonic clock from the moment its
+// goroutine begins executing it until it returns.
+// The duration of a function abandoned due to a timeout or cancellation is 0, as it had not
+// returned by the time this function returned.
+func RunSliceTimed[T any](
+	ctx context.Context,
+	funcs ...func(context.Context) (T, error),
+) ([]TimedResult[T], error) {
+	funcs = nonNilFuncs(funcs)
+	durations := make([]atomic.Int64, len(funcs))
+
+	timed := make([]func(context.Context) (T, error), len(funcs))
+	for i, f := range funcs {
+		i, f := i, f
+		timed[i] = func(ctx context.Context) (T, error) {
+			start := time.Now()
+			defer func() {
+				durations[i].Store(int64(time.Since(start)))
+			}()
+			return f(ctx)
+		}
+	}
+
+	results, err := RunSlice(ctx, timed...)
+	if results == nil {
+		return nil, err
+	}
+
+	timedResults := make([]TimedResult[T], len(results))
+	for i, res := range results {
+		timedResults[i] = TimedResult[T]{res, time.Duration(durations[i].Load())}
+	}
+	return timedResults, err
+}
+
+// RunSlicePerTimeout is like RunSlice, except that each function is executed with its own
+// context, derived from ctx with the addition of timeout, so a slow function times-out in its
+// own slot, with a TimeoutError, without affecting the others.
+// Each derived context is cancelled as soon as the corresponding function returns or times-out.
+func RunSlicePerTimeout[T any](
+	ctx context.Context,
+	timeout time.Duration,
+	funcs ...func(context.Context) (T, error),
+) ([]ResultWithError[T], error) {
+	funcs = nonNilFuncs(funcs)
+	timed := make([]func(context.Context) (T, error), len(funcs))
+	for i, f := range funcs {
+		i, f := i, f
+		timed[i] = func(ctx context.Context) (T, error) {
+			ctx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+			return rdv.CtxApplyWatch(ctx, f)()
+		}
+	}
+	return RunSlice(ctx, timed...)
 }
 
 // Run2 runs funcs concurrently and returns a tuple containing the results of
@@ -77,8 +337,8 @@ func Run2[T1, T2 any](
 	rv2 := rdv.Go(rdv.CtxApply(ctx, f2))
 
 	results := util.Tuple2[ResultWithError[T1], ResultWithError[T2]]{}
-	results.X1.Value, results.X1.Error = rv1.ReceiveWatch(ctx)
-	results.X2.Value, results.X2.Error = rv2.ReceiveWatch(ctx)
+	results.X1 = makeResultWithError(rv1.ReceiveWatch(ctx))
+	results.X2 = makeResultWithError(rv2.ReceiveWatch(ctx))
 
 	var err error = nil
 	errs := []error{results.X1.Error, results.X2.Error}
@@ -92,6 +352,79 @@ func Run2[T1, T2 any](
 	return results, err
 }
 
+// Run3 runs funcs concurrently and returns a tuple containing the results of
+// the function executions once all functions complete normally, with an error, or with a panic.
+// Panics in function executions are converted to errors.
+// In case of a context timeout or cancellation, this function returns early with a
+// TimeoutError or CancellationError for each of the funcs that had not already returned.
+// If there are any errors, the returned error is the one associated with the first function
+// in the list of arguments that has an error response (not necessarily the first function to
+// return an error).
+func Run3[T1, T2, T3 any](
+	ctx context.Context,
+	f1 func(context.Context) (T1, error),
+	f2 func(context.Context) (T2, error),
+	f3 func(context.Context) (T3, error),
+) (util.Tuple3[ResultWithError[T1], ResultWithError[T2], ResultWithError[T3]], error) {
+	rv1 := rdv.Go(rdv.CtxApply(ctx, f1))
+	rv2 := rdv.Go(rdv.CtxApply(ctx, f2))
+	rv3 := rdv.Go(rdv.CtxApply(ctx, f3))
+
+	results := util.Tuple3[ResultWithError[T1], ResultWithError[T2], ResultWithError[T3]]{}
+	results.X1 = makeResultWithError(rv1.ReceiveWatch(ctx))
+	results.X2 = makeResultWithError(rv2.ReceiveWatch(ctx))
+	results.X3 = makeResultWithError(rv3.ReceiveWatch(ctx))
+
+	var err error = nil
+	errs := []error{results.X1.Error, results.X2.Error, results.X3.Error}
+	for _, e := range errs {
+		if e != nil {
+			err = e
+			break
+		}
+	}
+
+	return results, err
+}
+
+// Run4 runs funcs concurrently and returns a tuple containing the results of
+// the function executions once all functions complete normally, with an error, or with a panic.
+// Panics in function executions are converted to errors.
+// In case of a context timeout or cancellation, this function returns early with a
+// TimeoutError or CancellationError for each of the funcs that had not already returned.
+// If there are any errors, the returned error is the one associated with the first function
+// in the list of arguments that has an error response (not necessarily the first function to
+// return an error).
+func Run4[T1, T2, T3, T4 any](
+	ctx context.Context,
+	f1 func(context.Context) (T1, error),
+	f2 func(context.Context) (T2, error),
+	f3 func(context.Context) (T3, error),
+	f4 func(context.Context) (T4, error),
+) (util.Tuple4[ResultWithError[T1], ResultWithError[T2], ResultWithError[T3], ResultWithError[T4]], error) {
+	rv1 := rdv.Go(rdv.CtxApply(ctx, f1))
+	rv2 := rdv.Go(rdv.CtxApply(ctx, f2))
+	rv3 := rdv.Go(rdv.CtxApply(ctx, f3))
+	rv4 := rdv.Go(rdv.CtxApply(ctx, f4))
+
+	results := util.Tuple4[ResultWithError[T1], ResultWithError[T2], ResultWithError[T3], ResultWithError[T4]]{}
+	results.X1 = makeResultWithError(rv1.ReceiveWatch(ctx))
+	results.X2 = makeResultWithError(rv2.ReceiveWatch(ctx))
+	results.X3 = makeResultWithError(rv3.ReceiveWatch(ctx))
+	results.X4 = makeResultWithError(rv4.ReceiveWatch(ctx))
+
+	var err error = nil
+	errs := []error{results.X1.Error, results.X2.Error, results.X3.Error, results.X4.Error}
+	for _, e := range errs {
+		if e != nil {
+			err = e
+			break
+		}
+	}
+
+	return results, err
+}
+
 // RunSliceEg runs funcs concurrently and returns a slice containing the non-error results
 // of the function executions if all functions complete normaly.  If any of the functions
 // returns an error or panics, this function returns early, with the first error encountered.
@@ -102,7 +435,27 @@ func RunSliceEg[T any](
 	ctx context.Context,
 	funcs ...func(context.Context) (T, error),
 ) ([]T, error) {
+	return RunSliceEgLimit(ctx, 0, funcs...)
+}
+
+// RunSliceEgLimit is like RunSliceEg, except that, if limit > 0, at most limit of the funcs
+// are launched at any given time, using errgroup.Group.SetLimit. Otherwise, it behaves
+// exactly like RunSliceEg.
+// Launching blocks while the limit is reached, but the rendezvous for each function is
+// allocated before its launch and the running functions never wait on the launching of the
+// others, so the blocking cannot cause a deadlock.
+// A function abandoned due to a timeout or cancellation frees its slot immediately, even if
+// it has not yet returned.
+func RunSliceEgLimit[T any](
+	ctx context.Context,
+	limit int,
+	funcs ...func(context.Context) (T, error),
+) ([]T, error) {
+	funcs = nonNilFuncs(funcs)
 	eg, egCtx := errgroup.WithContext(ctx)
+	if limit > 0 {
+		eg.SetLimit(limit)
+	}
 	rvs := make([]rdv.Rdv[T], len(funcs))
 	for i, f := range funcs {
 		rvs[i] = rdv.GoEg(eg, rdv.CtxApplyWatch(egCtx, f))
@@ -115,12 +468,157 @@ func RunSliceEg[T any](
 
 	results := make([]T, len(funcs))
 	for i := 0; i < len(rvs); i++ {
-		results[i], _ = rvs[i].Receive()
+		results[i], err = rvs[i].Receive()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return results, err
+}
+
+// RunSliceEgOrdered is like RunSliceEg, except that the funcs start executing in the order of
+// the list of arguments: each function's goroutine is launched only after the previous
+// function has begun executing, e.g., for functions that acquire ordered resources.
+// Only the start of the executions is ordered; the functions then run concurrently and may
+// complete in any order. Once the context is timed-out or cancelled, the remaining functions
+// are not executed.
+func RunSliceEgOrdered[T any](
+	ctx context.Context,
+	funcs ...func(context.Context) (T, error),
+) ([]T, error) {
+	funcs = nonNilFuncs(funcs)
+	eg, egCtx := errgroup.WithContext(ctx)
+	rvs := make([]rdv.Rdv[T], len(funcs))
+	for i, f := range funcs {
+		f := f
+		started := make(chan struct{})
+		gated := func(ctx context.Context) (T, error) {
+			close(started)
+			return f(ctx)
+		}
+		rvs[i] = rdv.GoEg(eg, rdv.CtxApplyWatch(egCtx, gated))
+		// The function is not executed at all if egCtx is already done.
+		select {
+		case <-started:
+		case <-egCtx.Done():
+		}
+	}
+
+	err := eg.Wait()
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]T, len(funcs))
+	for i := 0; i < len(rvs); i++ {
+		results[i], err = rvs[i].Receive()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return results, err
+}
+
+// RunSliceEgPartial is like RunSliceEg, except that, if any of the functions returns an error
+// or panics, the returned slice contains the non-error results of the functions that
+// completed successfully, and the zero value of T for the others, along with the first error
+// encountered.
+// The results are in the order of the corresponding functions in the list of arguments.
+func RunSliceEgPartial[T any](
+	ctx context.Context,
+	funcs ...func(context.Context) (T, error),
+) ([]T, error) {
+	funcs = nonNilFuncs(funcs)
+	eg, egCtx := errgroup.WithContext(ctx)
+	rvs := make([]rdv.Rdv[T], len(funcs))
+	for i, f := range funcs {
+		rvs[i] = rdv.GoEg(eg, rdv.CtxApplyWatch(egCtx, f))
+	}
+
+	err := eg.Wait()
+
+	// All results are available once eg.Wait returns.
+	results := make([]T, len(funcs))
+	for i := 0; i < len(rvs); i++ {
+		value, errI := rvs[i].Receive()
+		if errI == nil {
+			results[i] = value
+		}
+	}
+
+	return results, err
+}
+
+// RunSliceEgResults is like RunSliceEg, except that, whether or not any of the functions
+// returns an error or panics, it returns a slice with the results of all the function
+// executions, in the order of the corresponding functions in the list of arguments, along with
+// the first error encountered, so the caller can inspect which functions succeeded despite
+// the error.
+// The functions abandoned due to the error, or to a context timeout or cancellation, have a
+// CancellationError or TimeoutError in their slots, which wraps the error that caused the
+// abandonment, if any (see util.ContextErr). So errors.Is(err, context.Canceled) distinguishes
+// the abandoned functions from those that actually failed, and the Panicked field is set only
+// in the slots of the functions that actually panicked.
+func RunSliceEgResults[T any](
+	ctx context.Context,
+	funcs ...func(context.Context) (T, error),
+) ([]ResultWithError[T], error) {
+	funcs = nonNilFuncs(funcs)
+	eg, egCtx := errgroup.WithContext(ctx)
+	rvs := make([]rdv.Rdv[T], len(funcs))
+	for i, f := range funcs {
+		rvs[i] = rdv.GoEg(eg, rdv.CtxApplyWatch(egCtx, f))
+	}
+
+	err := eg.Wait()
+
+	// All results are available once eg.Wait returns.
+	results := make([]ResultWithError[T], len(funcs))
+	for i := 0; i < len(rvs); i++ {
+		results[i] = makeResultWithError(rvs[i].Receive())
 	}
 
 	return results, err
 }
 
+// RunSliceEgProgress is like RunSliceEg, except that onProgress is invoked each time one of
+// the funcs returns, normally, with an error, or with a panic, with the number of functions
+// that have returned so far and the total number of functions, e.g., to report "37/200 done".
+// onProgress is invoked from the goroutines executing the funcs, so it may be invoked
+// concurrently and must be safe for concurrent use. The count is maintained atomically, so
+// each invocation receives a distinct count, though not necessarily in increasing order.
+// Functions abandoned due to a timeout or cancellation are counted only when they return.
+// A nil onProgress makes this function equivalent to RunSliceEg. A panic in onProgress is
+// ignored, so it cannot override the result of the function being reported.
+func RunSliceEgProgress[T any](
+	ctx context.Context,
+	onProgress func(completed, total int),
+	funcs ...func(context.Context) (T, error),
+) ([]T, error) {
+	funcs = nonNilFuncs(funcs)
+	if onProgress == nil {
+		return RunSliceEg(ctx, funcs...)
+	}
+	var completed atomic.Int32
+	total := len(funcs)
+
+	reporting := make([]func(context.Context) (T, error), len(funcs))
+	for i, f := range funcs {
+		i, f := i, f
+		reporting[i] = func(ctx context.Context) (T, error) {
+			defer func() {
+				count := int(completed.Add(1))
+				_ = util.SafeFunc0V(func() { onProgress(count, total) })()
+			}()
+			return f(ctx)
+		}
+	}
+
+	return RunSliceEg(ctx, reporting...)
+}
+
 // Run2Eg runs funcs concurrently and returns a tuple containing the non-error results
 // of the function executions if all functions complete normaly.  If any of the functions
 // returns an error or panics, this function returns early, with the first error encountered.
@@ -143,12 +641,413 @@ func Run2Eg[T1, T2 any](
 		return results, err
 	}
 
-	results.X1, _ = rv1.ReceiveWatch(ctx)
-	results.X2, _ = rv2.ReceiveWatch(ctx)
+	errs := make([]error, 2)
+	results.X1, errs[0] = rv1.Receive()
+	results.X2, errs[1] = rv2.Receive()
+
+	for _, e := range errs {
+		if e != nil {
+			return util.Tuple2[T1, T2]{}, e
+		}
+	}
 
 	return results, err
 }
 
+// RunReduce runs funcs concurrently, with the same semantics as RunSliceEg, and folds the
+// non-error results of the function executions into an accumulator, starting with init.
+// combine is called serially, in the order of the corresponding functions in the list of
+// arguments, after all functions complete, so it need not be thread-safe.
+// If any of the functions returns an error or panics, this function returns early, with init
+// and the first error encountered.
+func RunReduce[T, A any](
+	ctx context.Context,
+	init A,
+	combine func(A, T) A,
+	funcs ...func(context.Context) (T, error),
+) (A, error) {
+	results, err := RunSliceEg(ctx, funcs...)
+	if err != nil {
+		return init, err
+	}
+
+	acc := init
+	for _, res := range results {
+		acc = combine(acc, res)
+	}
+	return acc, nil
+}
+
+// RunSliceSorted runs funcs concurrently, with the same semantics as RunSliceEg, and returns
+// the non-error results of the function executions sorted according to less, instead of in
+// the order of the corresponding functions in the list of arguments.
+// The sort is stable, so results that are equal according to less remain in the order of the
+// corresponding functions.
+func RunSliceSorted[T any](
+	ctx context.Context,
+	less func(T, T) bool,
+	funcs ...func(context.Context) (T, error),
+) ([]T, error) {
+	results, err := RunSliceEg(ctx, funcs...)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		return less(results[i], results[j])
+	})
+	return results, nil
+}
+
+// RunSliceEgWith launches funcs concurrently in the caller-supplied errgroup.Group eg, whose
+// derived context is egCtx, and returns an rdv.Rdv that encapsulates a slice containing the
+// non-error results of the function executions if all functions complete normally.
+// This function does not wait on eg, so the computations can be mixed with other eg.Go
+// calls and the caller can wait once on eg for all of them.
+// If any of the functions returns an error or panics, the errgroup cancels egCtx, which causes
+// the remaining functions to be abandoned, and the rdv.Rdv delivers the error of the first
+// failing function in the list of arguments (the first error encountered is the one returned
+// by eg.Wait).
+// Panics in function executions are converted to errors.
+func RunSliceEgWith[T any](
+	eg *errgroup.Group,
+	egCtx context.Context,
+	funcs ...func(context.Context) (T, error),
+) rdv.Rdv[[]T] {
+	funcs = nonNilFuncs(funcs)
+	rvs := make([]rdv.Rdv[T], len(funcs))
+	for i, f := range funcs {
+		rvs[i] = rdv.GoEg(eg, rdv.CtxApplyWatch(egCtx, f))
+	}
+
+	return rdv.Go(func() ([]T, error) {
+		results := make([]T, len(rvs))
+		for i := 0; i < len(rvs); i++ {
+			var err error
+			results[i], err = rvs[i].Receive()
+			if err != nil {
+				return nil, err
+			}
+		}
+		return results, nil
+	})
+}
+
+// Run2EgWith launches f1 and f2 concurrently in the caller-supplied errgroup.Group eg, whose
+// derived context is egCtx, and returns an rdv.Rdv that encapsulates a tuple containing the
+// non-error results of the function executions if all functions complete normally.
+// It has the same semantics as RunSliceEgWith.
+func Run2EgWith[T1, T2 any](
+	eg *errgroup.Group,
+	egCtx context.Context,
+	f1 func(context.Context) (T1, error),
+	f2 func(context.Context) (T2, error),
+) rdv.Rdv[util.Tuple2[T1, T2]] {
+	rv1 := rdv.GoEg(eg, rdv.CtxApplyWatch(egCtx, f1))
+	rv2 := rdv.GoEg(eg, rdv.CtxApplyWatch(egCtx, f2))
+
+	return rdv.Go(func() (util.Tuple2[T1, T2], error) {
+		results := util.Tuple2[T1, T2]{}
+		errs := make([]error, 2)
+		results.X1, errs[0] = rv1.Receive()
+		results.X2, errs[1] = rv2.Receive()
+
+		for _, e := range errs {
+			if e != nil {
+				return util.Tuple2[T1, T2]{}, e
+			}
+		}
+
+		return results, nil
+	})
+}
+
+// Run3Eg runs funcs concurrently and returns a tuple containing the non-error results
+// of the function executions if all functions complete normally.  If any of the functions
+// returns an error or panics, this function returns early, with the first error encountered.
+// Panics in function executions are converted to errors.
+// In case of a context timeout or cancellation, this function returns early with a
+// TimeoutError or CancellationError.
+func Run3Eg[T1, T2, T3 any](
+	ctx context.Context,
+	f1 func(context.Context) (T1, error),
+	f2 func(context.Context) (T2, error),
+	f3 func(context.Context) (T3, error),
+) (util.Tuple3[T1, T2, T3], error) {
+	eg, egCtx := errgroup.WithContext(ctx)
+	rv1 := rdv.GoEg(eg, rdv.CtxApplyWatch(egCtx, f1))
+	rv2 := rdv.GoEg(eg, rdv.CtxApplyWatch(egCtx, f2))
+	rv3 := rdv.GoEg(eg, rdv.CtxApplyWatch(egCtx, f3))
+
+	results := util.Tuple3[T1, T2, T3]{}
+
+	err := eg.Wait()
+	if err != nil {
+		return results, err
+	}
+
+	errs := make([]error, 3)
+	results.X1, errs[0] = rv1.Receive()
+	results.X2, errs[1] = rv2.Receive()
+	results.X3, errs[2] = rv3.Receive()
+
+	for _, e := range errs {
+		if e != nil {
+			return util.Tuple3[T1, T2, T3]{}, e
+		}
+	}
+
+	return results, err
+}
+
+// Run4Eg runs funcs concurrently and returns a tuple containing the non-error results
+// of the function executions if all functions complete normally.  If any of the functions
+// returns an error or panics, this function returns early, with the first error encountered.
+// Panics in function executions are converted to errors.
+// In case of a context timeout or cancellation, this function returns early with a
+// TimeoutError or CancellationError.
+func Run4Eg[T1, T2, T3, T4 any](
+	ctx context.Context,
+	f1 func(context.Context) (T1, error),
+	f2 func(context.Context) (T2, error),
+	f3 func(context.Context) (T3, error),
+	f4 func(context.Context) (T4, error),
+) (util.Tuple4[T1, T2, T3, T4], error) {
+	eg, egCtx := errgroup.WithContext(ctx)
+	rv1 := rdv.GoEg(eg, rdv.CtxApplyWatch(egCtx, f1))
+	rv2 := rdv.GoEg(eg, rdv.CtxApplyWatch(egCtx, f2))
+	rv3 := rdv.GoEg(eg, rdv.CtxApplyWatch(egCtx, f3))
+	rv4 := rdv.GoEg(eg, rdv.CtxApplyWatch(egCtx, f4))
+
+	results := util.Tuple4[T1, T2, T3, T4]{}
+
+	err := eg.Wait()
+	if err != nil {
+		return results, err
+	}
+
+	errs := make([]error, 4)
+	results.X1, errs[0] = rv1.Receive()
+	results.X2, errs[1] = rv2.Receive()
+	results.X3, errs[2] = rv3.Receive()
+	results.X4, errs[3] = rv4.Receive()
+
+	for _, e := range errs {
+		if e != nil {
+			return util.Tuple4[T1, T2, T3, T4]{}, e
+		}
+	}
+
+	return results, err
+}
+
+// RunSlicePool runs funcs concurrently, with at most maxConcurrency of them executing at any
+// given time, and returns a slice containing the non-error results of the function executions
+// if all functions complete normally.  If any of the functions returns an error or panics,
+// this function returns early, with the first error encountered, and launches no further
+// functions.
+// Panics in function executions are converted to errors.
+// In case of a context timeout or cancellation, this function returns early with a
+// TimeoutError or CancellationError.
+// If maxConcurrency <= 0, this function behaves exactly like RunSliceEg.
+func RunSlicePool[T any](
+	ctx context.Context,
+	maxConcurrency int,
+	funcs ...func(context.Context) (T, error),
+) ([]T, error) {
+	funcs = nonNilFuncs(funcs)
+	if maxConcurrency <= 0 {
+		return RunSliceEg(ctx, funcs...)
+	}
+
+	eg, egCtx := errgroup.WithContext(ctx)
+	sem := make(chan util.Unit, maxConcurrency)
+	rvs := make([]rdv.Rdv[T], len(funcs))
+	for i, f := range funcs {
+		select {
+		case sem <- util.Unit{}:
+		case <-egCtx.Done():
+			err := eg.Wait()
+			if err == nil {
+				err = util.ContextErr(ctx)
+			}
+			return nil, err
+		}
+		f := f
+		// The semaphore slot is released when f returns, not when its result is abandoned.
+		fRelease := func(ctx context.Context) (T, error) {
+			defer func() { <-sem }()
+			return f(ctx)
+		}
+		rvs[i] = rdv.GoEg(eg, rdv.CtxApplyWatch(egCtx, fRelease))
+	}
+
+	err := eg.Wait()
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]T, len(funcs))
+	for i := 0; i < len(rvs); i++ {
+		results[i], err = rvs[i].Receive()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return results, err
+}
+
+/////////////////////
+// Race
+
+// ErrRaceLost is the cause of the cancellation of the context passed to the functions that
+// lose a race (see Race and context.Cause).
+var ErrRaceLost = errors.New("race already won")
+
+// Race runs funcs concurrently and returns the result of the first function to complete
+// without an error, cancelling the context passed to the remaining functions with ErrRaceLost
+// as the cause.
+// Panics in function executions are converted to errors.
+// If all functions return errors, the returned error is the one from the last function
+// to complete.
+// In case of a context timeout or cancellation, this function returns early with a
+// TimeoutError or CancellationError.
+// If funcs is empty, this function returns the zero value of T and a nil error.
+func Race[T any](
+	ctx context.Context,
+	funcs ...func(context.Context) (T, error),
+) (T, error) {
+	_, value, err := RaceIndexed(ctx, funcs...)
+	return value, err
+}
+
+// RaceIndexed is like Race, except that it also returns the index of the winning function in
+// the list of arguments, e.g., to record which of several backends responded first. If there
+// is no winner, the index is -1.
+// Functions that complete after the winner never affect the index returned.
+func RaceIndexed[T any](
+	ctx context.Context,
+	funcs ...func(context.Context) (T, error),
+) (int, T, error) {
+	funcs = nonNilFuncs(funcs)
+	raceCtx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	// Buffered so that the losing goroutines never block.
+	resCh := make(chan util.Tuple2[int, ResultWithError[T]], len(funcs))
+	for i, f := range funcs {
+		i, fs := i, util.SafeFunc0E(rdv.CtxApply(raceCtx, f))
+		go func() {
+			resCh <- util.MakeTuple2(i, makeResultWithError(fs()))
+		}()
+	}
+
+	var zero T
+	var err error = nil
+	for range funcs {
+		select {
+		case ires := <-resCh:
+			i, res := ires.X1, ires.X2
+			if res.Error == nil {
+				cancel(ErrRaceLost)
+				return i, res.Value, nil
+			}
+			err = res.Error
+		case <-ctx.Done():
+			return -1, zero, util.ContextErr(ctx)
+		}
+	}
+
+	return -1, zero, err
+}
+
+// RaceEither is the heterogeneous analog of Race for two functions: it runs f1 and f2
+// concurrently and returns a util.OneOf2 holding the result of the first function to complete
+// without an error, cancelling the context passed to the other function.
+// Errors, timeouts, and cancellations are handled as in Race.
+func RaceEither[T1, T2 any](
+	ctx context.Context,
+	f1 func(context.Context) (T1, error),
+	f2 func(context.Context) (T2, error),
+) (util.OneOf2[T1, T2], error) {
+	return Race(
+		ctx,
+		func(ctx context.Context) (util.OneOf2[T1, T2], error) {
+			value, err := rdv.CtxApply(ctx, f1)()
+			return util.MakeOneOf2First[T1, T2](value), err
+		},
+		func(ctx context.Context) (util.OneOf2[T1, T2], error) {
+			value, err := rdv.CtxApply(ctx, f2)()
+			return util.MakeOneOf2Second[T1](value), err
+		},
+	)
+}
+
+// ErrQuorumNotReached is the error returned by RunQuorum when fewer than the required number
+// of functions succeed.
+var ErrQuorumNotReached = errors.New("quorum not reached")
+
+// ErrQuorumReached is the cause of the cancellation of the context passed to the functions
+// that are still running when RunQuorum reaches its quorum (see context.Cause).
+var ErrQuorumReached = errors.New("quorum already reached")
+
+// RunQuorum runs funcs concurrently and returns the results of the first n functions to
+// complete without an error, in completion order, cancelling the context passed to the
+// remaining functions with ErrQuorumReached as the cause.
+// Panics in function executions are converted to errors.
+// If so many functions return errors that n successes can no longer be obtained, this function
+// returns early with the successful results obtained so far and a *util.MultiError that
+// aggregates ErrQuorumNotReached and the errors of the functions that failed, in completion
+// order.
+// In case of a context timeout or cancellation, this function returns early with the
+// successful results obtained so far and a TimeoutError or CancellationError.
+// If n <= 0, no function is executed and this function returns an empty slice and a nil error.
+func RunQuorum[T any](
+	ctx context.Context,
+	n int,
+	funcs ...func(context.Context) (T, error),
+) ([]T, error) {
+	funcs = nonNilFuncs(funcs)
+	if n <= 0 {
+		return []T{}, nil
+	}
+
+	quorumCtx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	// Buffered so that the functions that complete after the quorum never block.
+	resCh := make(chan ResultWithError[T], len(funcs))
+	for _, f := range funcs {
+		fs := util.SafeFunc0E(rdv.CtxApply(quorumCtx, f))
+		go func() {
+			resCh <- makeResultWithError(fs())
+		}()
+	}
+
+	values := make([]T, 0, n)
+	errs := []error{ErrQuorumNotReached}
+	for failed := 0; len(funcs)-failed >= n; {
+		select {
+		case res := <-resCh:
+			if res.Error != nil {
+				errs = append(errs, res.Error)
+				failed++
+				continue
+			}
+			values = append(values, res.Value)
+			if len(values) == n {
+				cancel(ErrQuorumReached)
+				return values, nil
+			}
+		case <-ctx.Done():
+			return values, util.ContextErr(ctx)
+		}
+	}
+
+	return values, util.MakeMultiError(errs...)
+}
+
 /////////////////////
 // Go multiple
 
@@ -169,6 +1068,147 @@ func GoSlice[T any](
 	return rdv.Go(f)
 }
 
+// ErrPending is the error in the slots of a GoSliceObservable snapshot whose functions have
+// not yet returned.
+var ErrPending = errors.New("result pending")
+
+// GoSliceObservable is like GoSlice, except that it also returns a function that returns a
+// snapshot of the results of the function executions so far, e.g., for a UI to poll for
+// progress while the computation proceeds.
+// The snapshot is a new slice each time, in the order of the corresponding functions in the
+// list of arguments, so it can be freely read and modified. The slots of the functions that
+// have not yet returned contain the zero value of T and ErrPending. A function abandoned due
+// to a timeout or cancellation remains pending in the snapshot until it returns.
+func GoSliceObservable[T any](
+	ctx context.Context,
+	funcs ...func(ctx context.Context) (T, error),
+) (rdv.Rdv[[]ResultWithError[T]], func() []ResultWithError[T]) {
+	funcs = nonNilFuncs(funcs)
+	var mu sync.Mutex
+	results := make([]ResultWithError[T], len(funcs))
+	for i := range results {
+		results[i].Error = ErrPending
+	}
+
+	observed := make([]func(context.Context) (T, error), len(funcs))
+	for i, f := range funcs {
+		i, fs := i, util.SafeFunc1E(f)
+		observed[i] = func(ctx context.Context) (T, error) {
+			res, err := fs(ctx)
+			mu.Lock()
+			results[i] = makeResultWithError(res, err)
+			mu.Unlock()
+			return res, err
+		}
+	}
+
+	snapshot := func() []ResultWithError[T] {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]ResultWithError[T](nil), results...)
+	}
+
+	return GoSlice(ctx, observed...), snapshot
+}
+
+// GoSliceFailFast returns an rdv.Rdv for the concurrent execution of the functions funcs,
+// that fails fast like GoSliceEg but retains the results like GoSlice.
+// The rdv.Rdv encapsulates a slice containing the results of the function executions,
+// in the order of the corresponding functions in the list of arguments. As soon as any of the
+// functions returns an error or panics, the context passed to the remaining functions is
+// cancelled and the rdv.Rdv completes with that error. The slots of the functions that had
+// not yet returned then contain the zero value of T and a CancellationError.
+// Panics in function executions are converted to errors.
+// In case of a context timeout or cancellation, the rdv.Rdv completes early with a
+// TimeoutError or CancellationError, which is also the error in the slots of the functions
+// that had not yet returned.
+func GoSliceFailFast[T any](
+	ctx context.Context,
+	funcs ...func(ctx context.Context) (T, error),
+) rdv.Rdv[[]ResultWithError[T]] {
+	funcs = nonNilFuncs(funcs)
+	return rdv.Go(func() ([]ResultWithError[T], error) {
+		ffCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		// Buffered so that the abandoned goroutines never block.
+		resCh := make(chan util.Tuple2[int, ResultWithError[T]], len(funcs))
+		for i, f := range funcs {
+			i, fs := i, util.SafeFunc0E(rdv.CtxApply(ffCtx, f))
+			go func() {
+				resCh <- util.MakeTuple2(i, makeResultWithError(fs()))
+			}()
+		}
+
+		results := make([]ResultWithError[T], len(funcs))
+		returned := make([]bool, len(funcs))
+		abandon := func(err error) []ResultWithError[T] {
+			for i := range results {
+				if !returned[i] {
+					results[i].Error = err
+				}
+			}
+			return results
+		}
+
+		for range funcs {
+			select {
+			case ires := <-resCh:
+				i, res := ires.X1, ires.X2
+				results[i], returned[i] = res, true
+				if res.Error != nil {
+					return abandon(context.Canceled), res.Error
+				}
+			case <-ctx.Done():
+				err := util.ContextErr(ctx)
+				return abandon(err), err
+			}
+		}
+
+		return results, nil
+	})
+}
+
+// GoMap returns an rdv.Rdv for the concurrent execution of f for each of the inputs, with the
+// same semantics as GoSlice.
+// The results in the slice encapsulated by the rdv.Rdv are in the order of the corresponding
+// inputs.
+func GoMap[I, T any](
+	ctx context.Context,
+	inputs []I,
+	f func(ctx context.Context, input I) (T, error),
+) rdv.Rdv[[]ResultWithError[T]] {
+	if f == nil {
+		return rdv.Completed[[]ResultWithError[T]](nil, rdv.ErrNilFunc)
+	}
+	funcs := make([]func(context.Context) (T, error), len(inputs))
+	for i, input := range inputs {
+		i, input := i, input
+		funcs[i] = func(ctx context.Context) (T, error) {
+			return f(ctx, input)
+		}
+	}
+	return GoSlice(ctx, funcs...)
+}
+
+// Gather returns an rdv.Rdv that encapsulates a slice containing the results of the
+// already-launched rvs, in the order of the corresponding rvs, once all of them complete.
+// Unlike GoSlice, it does not launch the computations, so they can be launched at different
+// times and places and joined later. A single goroutine receives from each of the rvs.
+// If there are any errors, the error delivered by the rdv.Rdv is the one associated with the
+// first of the rvs that has an error response.
+func Gather[T any](rvs []rdv.Rdv[T]) rdv.Rdv[[]ResultWithError[T]] {
+	return rdv.Go(func() ([]ResultWithError[T], error) {
+		results := make([]ResultWithError[T], len(rvs))
+		errs := make([]error, len(rvs))
+		for i, rv := range rvs {
+			results[i] = makeResultWithError(rv.Receive())
+			errs[i] = results[i].Error
+		}
+		return results, FirstError(errs)
+	})
+}
+
 // Go2 returns an rdv.Rdv for the concurrent execution of the functions f1 and f2.
 // The rdv.Rdv encapsulates a tuple containing the results of
 // the function executions once all functions complete normaly, with an error, or with a panic.
@@ -186,6 +1226,43 @@ func Go2[T1 any, T2 any](
 	return rdv.Go(f)
 }
 
+// Go3 returns an rdv.Rdv for the concurrent execution of the functions f1, f2, and f3.
+// The rdv.Rdv encapsulates a tuple containing the results of
+// the function executions once all functions complete normally, with an error, or with a panic.
+// Panics in function executions are converted to errors.
+// In case of a context timeout or cancellation, the rdv.Rdv completes early with a
+// TimeoutError or CancellationError.
+func Go3[T1, T2, T3 any](
+	ctx context.Context,
+	f1 func(ctx context.Context) (T1, error),
+	f2 func(ctx context.Context) (T2, error),
+	f3 func(ctx context.Context) (T3, error),
+) rdv.Rdv[util.Tuple3[ResultWithError[T1], ResultWithError[T2], ResultWithError[T3]]] {
+	f := func() (util.Tuple3[ResultWithError[T1], ResultWithError[T2], ResultWithError[T3]], error) {
+		return Run3[T1, T2, T3](ctx, f1, f2, f3)
+	}
+	return rdv.Go(f)
+}
+
+// Go4 returns an rdv.Rdv for the concurrent execution of the functions f1, f2, f3, and f4.
+// The rdv.Rdv encapsulates a tuple containing the results of
+// the function executions once all functions complete normally, with an error, or with a panic.
+// Panics in function executions are converted to errors.
+// In case of a context timeout or cancellation, the rdv.Rdv completes early with a
+// TimeoutError or CancellationError.
+func Go4[T1, T2, T3, T4 any](
+	ctx context.Context,
+	f1 func(ctx context.Context) (T1, error),
+	f2 func(ctx context.Context) (T2, error),
+	f3 func(ctx context.Context) (T3, error),
+	f4 func(ctx context.Context) (T4, error),
+) rdv.Rdv[util.Tuple4[ResultWithError[T1], ResultWithError[T2], ResultWithError[T3], ResultWithError[T4]]] {
+	f := func() (util.Tuple4[ResultWithError[T1], ResultWithError[T2], ResultWithError[T3], ResultWithError[T4]], error) {
+		return Run4[T1, T2, T3, T4](ctx, f1, f2, f3, f4)
+	}
+	return rdv.Go(f)
+}
+
 // GoSliceEg returns an rdv.Rdv for the concurrent execution of the functions funcs
 // in an errgroup.Group.
 // The rdv.Rdv encapsulates a slice containing the non-error results
@@ -222,3 +1299,143 @@ func Go2Eg[T1 any, T2 any](
 	}
 	return rdv.Go(f)
 }
+
+// Go3Eg returns an rdv.Rdv for the concurrent execution of the functions f1, f2, and f3
+// in an errgroup.Group.
+// The rdv.Rdv encapsulates a tuple containing the non-error results
+// of the function executions if all functions complete normally.  If any of the functions
+// returns an error or panics, this function returns early, with the first error encountered.
+// Panics in function executions are converted to errors.
+// In case of a context timeout or cancellation, the rdv.Rdv completes early with a
+// TimeoutError or CancellationError.
+func Go3Eg[T1, T2, T3 any](
+	ctx context.Context,
+	f1 func(ctx context.Context) (T1, error),
+	f2 func(ctx context.Context) (T2, error),
+	f3 func(ctx context.Context) (T3, error),
+) rdv.Rdv[util.Tuple3[T1, T2, T3]] {
+	f := func() (util.Tuple3[T1, T2, T3], error) {
+		return Run3Eg[T1, T2, T3](ctx, f1, f2, f3)
+	}
+	return rdv.Go(f)
+}
+
+// Go4Eg returns an rdv.Rdv for the concurrent execution of the functions f1, f2, f3, and f4
+// in an errgroup.Group.
+// The rdv.Rdv encapsulates a tuple containing the non-error results
+// of the function executions if all functions complete normally.  If any of the functions
+// returns an error or panics, this function returns early, with the first error encountered.
+// Panics in function executions are converted to errors.
+// In case of a context timeout or cancellation, the rdv.Rdv completes early with a
+// TimeoutError or CancellationError.
+func Go4Eg[T1, T2, T3, T4 any](
+	ctx context.Context,
+	f1 func(ctx context.Context) (T1, error),
+	f2 func(ctx context.Context) (T2, error),
+	f3 func(ctx context.Context) (T3, error),
+	f4 func(ctx context.Context) (T4, error),
+) rdv.Rdv[util.Tuple4[T1, T2, T3, T4]] {
+	f := func() (util.Tuple4[T1, T2, T3, T4], error) {
+		return Run4Eg[T1, T2, T3, T4](ctx, f1, f2, f3, f4)
+	}
+	return rdv.Go(f)
+}
+
+/////////////////////
+// Stream
+
+// GoStream runs funcs concurrently and returns a channel on which the results of the function
+// executions are emitted, in completion order, each paired with the index of the corresponding
+// function in the list of arguments.
+// Panics in function executions are converted to errors.
+// The channel is closed once all results have been emitted. In case of a context timeout or
+// cancellation, no further results are emitted and the channel is closed as soon as the
+// functions that had not already returned are abandoned.
+// The channel is buffered with capacity len(funcs), so the function executions never block on
+// a slow consumer and the results not consumed are discarded when the channel is garbage
+// collected. See GoStreamBuffered to control the buffer size.
+func GoStream[T any](
+	ctx context.Context,
+	funcs ...func(context.Context) (T, error),
+) <-chan util.Tuple2[int, ResultWithError[T]] {
+	return GoStreamBuffered(ctx, len(funcs), funcs...)
+}
+
+// GoStreamBuffered is like GoStream, except that the channel is buffered with capacity buffer,
+// to tune backpressure. With a buffer of 0, each function execution blocks, after it returns,
+// until its result is consumed. With a larger buffer, up to buffer results are emitted without
+// waiting on the consumer.
+// A blocked emission is abandoned as soon as ctx is cancelled or times-out, so a consumer that
+// stops reading from the channel before it is closed must cancel ctx to ensure that the
+// goroutines used to execute and emit from the funcs terminate; otherwise, unless buffer is at
+// least len(funcs), they remain blocked.
+// A negative buffer is treated as 0.
+func GoStreamBuffered[T any](
+	ctx context.Context,
+	buffer int,
+	funcs ...func(context.Context) (T, error),
+) <-chan util.Tuple2[int, ResultWithError[T]] {
+	funcs = nonNilFuncs(funcs)
+	buffer = max(buffer, 0)
+	out := make(chan util.Tuple2[int, ResultWithError[T]], buffer)
+
+	var wg sync.WaitGroup
+	wg.Add(len(funcs))
+	for i, f := range funcs {
+		i, fw := i, rdv.CtxApplyWatch(ctx, f)
+		go func() {
+			defer wg.Done()
+			res := makeResultWithError(fw())
+			if ctx.Err() != nil {
+				return
+			}
+			select {
+			case out <- util.MakeTuple2(i, res):
+			case <-ctx.Done():
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+
+	return out
+}
+
+// GoStreamOrdered runs funcs concurrently and returns a channel on which the results of the
+// function executions are emitted in the order of the corresponding functions in the list of
+// arguments.
+// Panics in function executions are converted to errors.
+// The channel is closed once all results have been emitted. In case of a context timeout or
+// cancellation, no further results are emitted and the channel is closed.
+// Results that complete ahead of their turn are retained until all preceding results have
+// been emitted, so up to len(funcs) results may be held in memory at any given time.
+// The channel is buffered with capacity len(funcs), so emission never blocks on a slow
+// consumer.
+func GoStreamOrdered[T any](
+	ctx context.Context,
+	funcs ...func(context.Context) (T, error),
+) <-chan ResultWithError[T] {
+	funcs = nonNilFuncs(funcs)
+	out := make(chan ResultWithError[T], len(funcs))
+
+	rvs := make([]rdv.Rdv[T], len(funcs))
+	for i, f := range funcs {
+		rvs[i] = rdv.Go(rdv.CtxApply(ctx, f))
+	}
+
+	go func() {
+		defer close(out)
+		for _, rv := range rvs {
+			res := makeResultWithError(rv.ReceiveWatch(ctx))
+			if ctx.Err() != nil {
+				return
+			}
+			out <- res
+		}
+	}()
+
+	return out
+}
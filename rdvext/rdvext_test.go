@@ -0,0 +1,89 @@
+/*
+ * Copyright © 2021 Paulo Villela. All rights reserved.
+ * Use of this source code is governed by the Apache 2.0 license
+ * that can be found in the LICENSE file.
+ */
+
+package rdvext
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pvillela/go-rendezvous/util"
+)
+
+func TestRunSlicePolicyPanickingPick(t *testing.T) {
+	pick := func(errs []error) error {
+		var counts map[string]int
+		counts["errors"] = len(errs) // panics: assignment to entry in nil map
+		return nil
+	}
+	f := func(ctx context.Context) (int, error) { return 1, nil }
+
+	results, err := RunSlicePolicy(context.Background(), pick, f, f)
+
+	var pe util.PanicError
+	if !errors.As(err, &pe) {
+		t.Fatalf("got error %v, want a util.PanicError", err)
+	}
+	if results != nil {
+		t.Errorf("got results %v, want nil", results)
+	}
+}
+
+func TestRunSliceEgOrderedStartOrder(t *testing.T) {
+	const n = 100
+	var mu sync.Mutex
+	order := make([]int, 0, n)
+
+	funcs := make([]func(context.Context) (int, error), n)
+	for i := range funcs {
+		i := i
+		funcs[i] = func(ctx context.Context) (int, error) {
+			mu.Lock()
+			order = append(order, i)
+			mu.Unlock()
+			return i, nil
+		}
+	}
+
+	results, err := RunSliceEgOrdered(context.Background(), funcs...)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if len(results) != n {
+		t.Fatalf("got %d results, want %d", len(results), n)
+	}
+	for i, j := range order {
+		if i != j {
+			t.Fatalf("function %d started at position %d; start order: %v", j, i, order)
+		}
+	}
+}
+
+func TestRunSliceEgResultsAbandonedSlot(t *testing.T) {
+	panicky := func(ctx context.Context) (int, error) { panic("boom") }
+	abandoned := func(ctx context.Context) (int, error) {
+		<-ctx.Done()
+		time.Sleep(10 * time.Millisecond)
+		return 1, nil
+	}
+
+	results, err := RunSliceEgResults(context.Background(), panicky, abandoned)
+
+	var pe util.PanicError
+	if !errors.As(err, &pe) {
+		t.Fatalf("got error %v, want a util.PanicError", err)
+	}
+	if !results[0].Panicked {
+		t.Errorf("slot 0: Panicked is false for the panicking function")
+	}
+	if got := results[1]; !errors.Is(got.Error, context.Canceled) || got.Panicked {
+		t.Errorf("slot 1: got error %v and Panicked %v, want a CancellationError and false",
+			got.Error, got.Panicked)
+	}
+}
@@ -0,0 +1,50 @@
+/*
+ * Copyright © 2021 Paulo Villela. All rights reserved.
+ * Use of this source code is governed by the Apache 2.0 license
+ * that can be found in the LICENSE file.
+ */
+
+// Test helpers to verify that context-aware functions launched with the rdv package honor
+// cancellation.
+package rdvtest
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/pvillela/go-rendezvous/rdv"
+)
+
+// AssertCancelsWithin launches f with a cancellable context, cancels the context, and reports
+// a test failure through t unless f returns a CancellationError within the duration d.
+// The Rdv used to launch f does not watch the context, so the assertion fails if f ignores
+// the cancellation of its context, which a watching Rdv would mask.
+// It returns the Rdv, so the caller can make further assertions on the results of f.
+func AssertCancelsWithin[T any](
+	t testing.TB,
+	f func(context.Context) (T, error),
+	d time.Duration,
+) rdv.Rdv[T] {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	rv := rdv.Go(rdv.CtxApply(ctx, f))
+	cancel()
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-rv.Done():
+	case <-timer.C:
+		t.Errorf("function did not return within %v of the cancellation of its context", d)
+		return rv
+	}
+
+	if _, err := rv.Receive(); !errors.Is(err, context.Canceled) {
+		t.Errorf("function returned error %v instead of a CancellationError after the "+
+			"cancellation of its context", err)
+	}
+	return rv
+}
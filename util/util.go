@@ -9,6 +9,10 @@ package util
 import (
 	"context"
 	"fmt"
+	"runtime/debug"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 )
 
@@ -25,10 +29,12 @@ func (err ErrorOf) Error() string {
 	return fmt.Sprintf("%v", err.Value)
 }
 
-// ToError transforms an arbitrary value x into an error. If x is an error, it does nothing.
-// Otherwise, it wraps x in an ErrorOf.
+// ToError transforms an arbitrary value x into an error. If x is nil, it returns nil.
+// If x is an error, including an ErrorOf, it does nothing. Otherwise, it wraps x in an ErrorOf.
 func ToError(x interface{}) error {
 	switch x.(type) {
+	case nil:
+		return nil
 	case error:
 		return x.(error)
 	default:
@@ -36,6 +42,119 @@ func ToError(x interface{}) error {
 	}
 }
 
+// PanicError is the error returned by the SafeFunc* functions when the function they wrap
+// panics. Value is the recovered value and Stack is the stack trace of the panicking goroutine
+// at the time of the recovery. The SafeFunc* functions also record the conversion of Value to
+// an error by the panic converter (see SetPanicConverter).
+type PanicError struct {
+	Value     interface{}
+	Stack     []byte
+	converted error
+}
+
+// Error implements the error interface
+func (err PanicError) Error() string {
+	if err.converted != nil {
+		return err.converted.Error()
+	}
+	return fmt.Sprintf("%v", err.Value)
+}
+
+// Unwrap returns the conversion of the recovered value to an error by the panic converter or,
+// if there is none, the recovered value if it is an error, supporting errors.Is and errors.As
+func (err PanicError) Unwrap() error {
+	if err.converted != nil {
+		return err.converted
+	}
+	if e, ok := err.Value.(error); ok {
+		return e
+	}
+	return nil
+}
+
+// MultiError is an error that aggregates multiple errors
+type MultiError struct {
+	errs []error
+}
+
+// MakeMultiError constructs a *MultiError from the non-nil errors in errs, like errors.Join,
+// so it can be retrieved with errors.As into a *MultiError variable.
+// It returns nil if all the errors in errs are nil.
+func MakeMultiError(errs ...error) error {
+	var nonNil []error
+	for _, err := range errs {
+		if err != nil {
+			nonNil = append(nonNil, err)
+		}
+	}
+	if len(nonNil) == 0 {
+		return nil
+	}
+	return &MultiError{nonNil}
+}
+
+// Error implements the error interface
+func (err *MultiError) Error() string {
+	msgs := make([]string, len(err.errs))
+	for i, e := range err.errs {
+		msgs[i] = e.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Errors returns the errors aggregated by the receiver
+func (err *MultiError) Errors() []error {
+	return err.errs
+}
+
+// Unwrap returns the errors aggregated by the receiver, supporting errors.Is and errors.As
+func (err *MultiError) Unwrap() []error {
+	return err.errs
+}
+
+// Result encapsulates a normal result value and an error.
+// Panicked reports whether the error resulted from a recovered panic (see PanicError).
+type Result[T any] struct {
+	Value    T
+	Err      error
+	Panicked bool
+}
+
+// MakeResult constructs a Result from value and err, setting Panicked if err is a PanicError.
+// An error that merely wraps a PanicError, e.g., a CancellationError whose cause is a panic in
+// another computation, does not set Panicked.
+func MakeResult[T any](value T, err error) Result[T] {
+	_, panicked := err.(PanicError)
+	return Result[T]{value, err, panicked}
+}
+
+// IsOk reports whether the receiver has a nil error
+func (r Result[T]) IsOk() bool {
+	return r.Err == nil
+}
+
+// Unwrap returns the value and error of the receiver
+func (r Result[T]) Unwrap() (T, error) {
+	return r.Value, r.Err
+}
+
+// ValueOr returns the value of the receiver if its error is nil and def otherwise
+func (r Result[T]) ValueOr(def T) T {
+	if r.Err != nil {
+		return def
+	}
+	return r.Value
+}
+
+// Map returns a Result with the value of the receiver transformed by f if the receiver's error
+// is nil. Otherwise, it returns the receiver.
+func (r Result[T]) Map(f func(T) T) Result[T] {
+	if r.Err != nil {
+		return r
+	}
+	return Result[T]{f(r.Value), nil, false}
+}
+
 // Tuple2 is tuple with 2 elements
 type Tuple2[T1, T2 any] struct {
 	X1 T1
@@ -57,6 +176,122 @@ type Tuple4[T1, T2, T3, T4 any] struct {
 	X4 T4
 }
 
+// OneOf2 is a tagged union holding either a value of type T1 or a value of type T2
+type OneOf2[T1, T2 any] struct {
+	first bool
+	x1    T1
+	x2    T2
+}
+
+// MakeOneOf2First constructs a OneOf2 holding the T1 value x1
+func MakeOneOf2First[T1, T2 any](x1 T1) OneOf2[T1, T2] {
+	return OneOf2[T1, T2]{first: true, x1: x1}
+}
+
+// MakeOneOf2Second constructs a OneOf2 holding the T2 value x2
+func MakeOneOf2Second[T1, T2 any](x2 T2) OneOf2[T1, T2] {
+	return OneOf2[T1, T2]{x2: x2}
+}
+
+// IsFirst reports whether the receiver holds a T1 value
+func (o OneOf2[T1, T2]) IsFirst() bool {
+	return o.first
+}
+
+// First returns the T1 value held by the receiver and true, or the zero value of T1 and false
+// if the receiver holds a T2 value
+func (o OneOf2[T1, T2]) First() (T1, bool) {
+	return o.x1, o.first
+}
+
+// Second returns the T2 value held by the receiver and true, or the zero value of T2 and false
+// if the receiver holds a T1 value
+func (o OneOf2[T1, T2]) Second() (T2, bool) {
+	return o.x2, !o.first
+}
+
+// OnPanic, if not nil, is invoked by the SafeFunc* functions with the recovered value and the
+// stack trace of each panic they convert to an error. It is intended for logging and metrics.
+// A panic in OnPanic is recovered and discarded.
+// OnPanic should be set during program initialization, before any SafeFunc* function is used.
+var OnPanic func(recovered interface{}, stack []byte)
+
+// panicConverter holds the function set with SetPanicConverter, if any.
+var panicConverter atomic.Pointer[func(recovered interface{}) error]
+
+// SetPanicConverter sets the function used by the SafeFunc* functions to convert the values
+// recovered from panics to errors, which are then wrapped in a PanicError. A nil convert
+// restores the default, which is ToError.
+// If convert panics or returns nil, ToError is used instead.
+// The converter is stored atomically, so it can be safely swapped at any time, although it
+// is normally set during program initialization.
+func SetPanicConverter(convert func(recovered interface{}) error) {
+	if convert == nil {
+		panicConverter.Store(nil)
+		return
+	}
+	panicConverter.Store(&convert)
+}
+
+// convertPanic converts the recovered value x to an error with the function set with
+// SetPanicConverter, falling back to ToError.
+func convertPanic(x interface{}) (err error) {
+	convert := panicConverter.Load()
+	if convert == nil {
+		return ToError(x)
+	}
+	defer func() {
+		if recover() != nil || err == nil {
+			err = ToError(x)
+		}
+	}()
+	return (*convert)(x)
+}
+
+// panicToError notifies OnPanic of the recovered value x and converts x to a PanicError.
+// It must be called from the deferred function that recovered x so that the stack trace
+// includes the panicking frames.
+func panicToError(x interface{}) error {
+	stack := debug.Stack()
+	if onPanic := OnPanic; onPanic != nil {
+		func() {
+			defer func() { _ = recover() }()
+			onPanic(x, stack)
+		}()
+	}
+	return PanicError{x, stack, convertPanic(x)}
+}
+
+// MakeTuple2 constructs a Tuple2
+func MakeTuple2[T1, T2 any](x1 T1, x2 T2) Tuple2[T1, T2] {
+	return Tuple2[T1, T2]{x1, x2}
+}
+
+// MakeTuple3 constructs a Tuple3
+func MakeTuple3[T1, T2, T3 any](x1 T1, x2 T2, x3 T3) Tuple3[T1, T2, T3] {
+	return Tuple3[T1, T2, T3]{x1, x2, x3}
+}
+
+// MakeTuple4 constructs a Tuple4
+func MakeTuple4[T1, T2, T3, T4 any](x1 T1, x2 T2, x3 T3, x4 T4) Tuple4[T1, T2, T3, T4] {
+	return Tuple4[T1, T2, T3, T4]{x1, x2, x3, x4}
+}
+
+// Tuple2ToSlice returns a slice with the elements of a homogeneous Tuple2, in order
+func Tuple2ToSlice[T any](t Tuple2[T, T]) []T {
+	return []T{t.X1, t.X2}
+}
+
+// Tuple3ToSlice returns a slice with the elements of a homogeneous Tuple3, in order
+func Tuple3ToSlice[T any](t Tuple3[T, T, T]) []T {
+	return []T{t.X1, t.X2, t.X3}
+}
+
+// Tuple4ToSlice returns a slice with the elements of a homogeneous Tuple4, in order
+func Tuple4ToSlice[T any](t Tuple4[T, T, T, T]) []T {
+	return []T{t.X1, t.X2, t.X3, t.X4}
+}
+
 // SafeFunc0E returns a function that never panics.
 // That function returns the same values as f if f doesn't panic and returns an error if f panics.
 func SafeFunc0E[U any](f func() (U, error)) func() (U, error) {
@@ -64,7 +299,7 @@ func SafeFunc0E[U any](f func() (U, error)) func() (U, error) {
 		defer func() {
 			err0 := recover()
 			if err0 != nil {
-				err = ToError(err0)
+				err = panicToError(err0)
 			}
 		}()
 		return f()
@@ -102,7 +337,7 @@ func SafeFunc1E[T1, U any](f func(T1) (U, error)) func(T1) (U, error) {
 		defer func() {
 			err0 := recover()
 			if err0 != nil {
-				err = ToError(err0)
+				err = panicToError(err0)
 			}
 		}()
 		return f(t1)
@@ -140,7 +375,7 @@ func SafeFunc2E[T1, T2, U any](f func(T1, T2) (U, error)) func(T1, T2) (U, error
 		defer func() {
 			err0 := recover()
 			if err0 != nil {
-				err = ToError(err0)
+				err = panicToError(err0)
 			}
 		}()
 		return f(t1, t2)
@@ -171,8 +406,158 @@ func SafeFunc2V[T1, T2 any](f func(T1, T2)) func(T1, T2) error {
 	return SafeFunc2VE(fe)
 }
 
+// SafeFunc3E returns a function that never panics.
+// That function returns the same values as f if f doesn't panic and returns an error if f panics.
+func SafeFunc3E[T1, T2, T3, U any](f func(T1, T2, T3) (U, error)) func(T1, T2, T3) (U, error) {
+	return func(t1 T1, t2 T2, t3 T3) (res U, err error) {
+		defer func() {
+			err0 := recover()
+			if err0 != nil {
+				err = panicToError(err0)
+			}
+		}()
+		return f(t1, t2, t3)
+	}
+}
+
+// SafeFunc3VE returns a function that never panics.
+// That function returns the same value as f if f doesn't panic and returns an error if f panics.
+func SafeFunc3VE[T1, T2, T3 any](f func(T1, T2, T3) error) func(T1, T2, T3) error {
+	fu := func(t1 T1, t2 T2, t3 T3) (Unit, error) { return Unit{}, f(t1, t2, t3) }
+	return func(t1 T1, t2 T2, t3 T3) error {
+		_, err := SafeFunc3E(fu)(t1, t2, t3)
+		return err
+	}
+}
+
+// SafeFunc3 returns a function that never panics.
+// That function returns the same value as f if f doesn't panic and returns an error if f panics.
+func SafeFunc3[T1, T2, T3, U any](f func(T1, T2, T3) U) func(T1, T2, T3) (U, error) {
+	fe := func(t1 T1, t2 T2, t3 T3) (U, error) { return f(t1, t2, t3), nil }
+	return SafeFunc3E(fe)
+}
+
+// SafeFunc3V returns a function that never panics.
+// That function returns nil if f doesn't panic and returns an error if f panics.
+func SafeFunc3V[T1, T2, T3 any](f func(T1, T2, T3)) func(T1, T2, T3) error {
+	fe := func(t1 T1, t2 T2, t3 T3) error { f(t1, t2, t3); return nil }
+	return SafeFunc3VE(fe)
+}
+
+// SafeFunc4E returns a function that never panics.
+// That function returns the same values as f if f doesn't panic and returns an error if f panics.
+func SafeFunc4E[T1, T2, T3, T4, U any](f func(T1, T2, T3, T4) (U, error)) func(T1, T2, T3, T4) (U, error) {
+	return func(t1 T1, t2 T2, t3 T3, t4 T4) (res U, err error) {
+		defer func() {
+			err0 := recover()
+			if err0 != nil {
+				err = panicToError(err0)
+			}
+		}()
+		return f(t1, t2, t3, t4)
+	}
+}
+
+// SafeFunc4VE returns a function that never panics.
+// That function returns the same value as f if f doesn't panic and returns an error if f panics.
+func SafeFunc4VE[T1, T2, T3, T4 any](f func(T1, T2, T3, T4) error) func(T1, T2, T3, T4) error {
+	fu := func(t1 T1, t2 T2, t3 T3, t4 T4) (Unit, error) { return Unit{}, f(t1, t2, t3, t4) }
+	return func(t1 T1, t2 T2, t3 T3, t4 T4) error {
+		_, err := SafeFunc4E(fu)(t1, t2, t3, t4)
+		return err
+	}
+}
+
+// SafeFunc4 returns a function that never panics.
+// That function returns the same value as f if f doesn't panic and returns an error if f panics.
+func SafeFunc4[T1, T2, T3, T4, U any](f func(T1, T2, T3, T4) U) func(T1, T2, T3, T4) (U, error) {
+	fe := func(t1 T1, t2 T2, t3 T3, t4 T4) (U, error) { return f(t1, t2, t3, t4), nil }
+	return SafeFunc4E(fe)
+}
+
+// SafeFunc4V returns a function that never panics.
+// That function returns nil if f doesn't panic and returns an error if f panics.
+func SafeFunc4V[T1, T2, T3, T4 any](f func(T1, T2, T3, T4)) func(T1, T2, T3, T4) error {
+	fe := func(t1 T1, t2 T2, t3 T3, t4 T4) error { f(t1, t2, t3, t4); return nil }
+	return SafeFunc4VE(fe)
+}
+
+// SafeVariadicE returns a function that never panics.
+// That function returns the same values as f if f doesn't panic and returns an error if f panics.
+func SafeVariadicE[T, U any](f func(...T) (U, error)) func(...T) (U, error) {
+	return func(ts ...T) (res U, err error) {
+		defer func() {
+			err0 := recover()
+			if err0 != nil {
+				err = panicToError(err0)
+			}
+		}()
+		return f(ts...)
+	}
+}
+
+// ContextErr returns the error of ctx (see context.Context.Err), which is nil if ctx is not
+// yet cancelled or timed-out. If ctx was cancelled with a cause other than that error (see
+// context.WithCancelCause), the returned error wraps both, so errors.Is reports both the
+// TimeoutError or CancellationError and the cause.
+func ContextErr(ctx context.Context) error {
+	err := ctx.Err()
+	if err == nil {
+		return nil
+	}
+	if cause := context.Cause(ctx); cause != nil && cause != err {
+		return fmt.Errorf("%w: %w", err, cause)
+	}
+	return err
+}
+
+// WaitGroup wraps a sync.WaitGroup to support waiting with a context (see Wait). The Add and
+// Done methods are those of the wrapped sync.WaitGroup.
+type WaitGroup struct {
+	*sync.WaitGroup
+}
+
+// MakeWaitGroup constructs a WaitGroup wrapping a new sync.WaitGroup
+func MakeWaitGroup() WaitGroup {
+	return WaitGroup{&sync.WaitGroup{}}
+}
+
+// Wait blocks until the counter of the wrapped sync.WaitGroup is zero or ctx is cancelled or
+// times-out, whichever comes first. In the latter case, it returns a TimeoutError or
+// CancellationError, and the goroutine used to wait on the sync.WaitGroup terminates when its
+// counter reaches zero.
+func (wg WaitGroup) Wait(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		wg.WaitGroup.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ContextErr(ctx)
+	}
+}
+
+// Contextualize returns a context-aware function that ignores its context argument and
+// returns the results of f, so that f can be passed where a function of a context is expected.
+// It returns nil if f is nil.
+func Contextualize[T any](f func() (T, error)) func(context.Context) (T, error) {
+	if f == nil {
+		return nil
+	}
+	return func(context.Context) (T, error) {
+		return f()
+	}
+}
+
 // RunWithTimeout executes function f with a context constructed from ctx with the addition
-// of timeout.
+// of timeout. If ctx already has an earlier deadline, that deadline prevails, so the effective
+// deadline is never extended beyond ctx's.
+// A panic in f is converted to an error.
+// For an asynchronous execution, see rdv.GoWithTimeout.
 func RunWithTimeout[T any](
 	ctx context.Context,
 	timeout time.Duration,
@@ -180,5 +565,5 @@ func RunWithTimeout[T any](
 ) (T, error) {
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
-	return f(ctx)
+	return SafeFunc1E(f)(ctx)
 }
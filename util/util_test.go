@@ -0,0 +1,36 @@
+/*
+ * Copyright © 2021 Paulo Villela. All rights reserved.
+ * Use of this source code is governed by the Apache 2.0 license
+ * that can be found in the LICENSE file.
+ */
+
+package util
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestToError(t *testing.T) {
+	plain := errors.New("plain")
+	nested := ErrorOf{ErrorOf{"inner"}}
+
+	tests := []struct {
+		name string
+		x    interface{}
+		want error
+	}{
+		{"nil", nil, nil},
+		{"string", "boom", ErrorOf{"boom"}},
+		{"error", plain, plain},
+		{"nested ErrorOf", nested, nested},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ToError(tt.x); got != tt.want {
+				t.Errorf("ToError(%#v) = %#v, want %#v", tt.x, got, tt.want)
+			}
+		})
+	}
+}